@@ -0,0 +1,31 @@
+package cliutil
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// MaxLineSize is the maximum size of a line read by ScanLines. Lines may
+// contain base64 encoded file data, so this is much larger than the default
+// bufio.Scanner buffer.
+const MaxLineSize = 64 * 1024 * 1024
+
+// ScanLines reads r line by line and invokes fn for each line that isn't
+// empty. Scanning stops at the first error returned by fn.
+func ScanLines(r io.Reader, fn func(line []byte) error) error {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), MaxLineSize)
+
+	for s.Scan() {
+		line := bytes.TrimSpace(s.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if err := fn(line); err != nil {
+			return err
+		}
+	}
+
+	return s.Err()
+}
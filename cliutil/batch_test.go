@@ -0,0 +1,45 @@
+package cliutil_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+)
+
+func TestScanLines(t *testing.T) {
+	r := strings.NewReader("{\"record_id\":1}\n\n  {\"record_id\":2}  \n")
+
+	lines := []string{}
+	err := cliutil.ScanLines(r, func(line []byte) error {
+		lines = append(lines, string(line))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %v", len(lines))
+	}
+	if lines[1] != `{"record_id":2}` {
+		t.Errorf("expected '{\"record_id\":2}', got '%s'", lines[1])
+	}
+}
+
+func TestScanLinesError(t *testing.T) {
+	r := strings.NewReader("1\n2\n3\n")
+
+	n := 0
+	err := cliutil.ScanLines(r, func(line []byte) error {
+		n++
+		return errors.New("test error")
+	})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if n != 1 {
+		t.Errorf("expected scanning to stop after the first error, got %v calls", n)
+	}
+}
@@ -2,6 +2,7 @@ package cliutil
 
 import (
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -40,6 +41,19 @@ func (f *Flagger) PersistentBool(name, shorthand string, value bool, usage strin
 	f.cfg.BindPFlag(name, f.cmd.PersistentFlags().Lookup(name))
 }
 
+// Duration adds a local flag that accepts a duration, e.g. "30s".
+func (f *Flagger) Duration(name, shorthand string, value time.Duration, usage string) {
+	f.cmd.Flags().DurationP(name, shorthand, value, usage)
+	f.cfg.BindPFlag(name, f.cmd.Flags().Lookup(name))
+}
+
+// PersistentDuration adds a persistent flag that accepts a duration, e.g.
+// "30s".
+func (f *Flagger) PersistentDuration(name, shorthand string, value time.Duration, usage string) {
+	f.cmd.PersistentFlags().DurationP(name, shorthand, value, usage)
+	f.cfg.BindPFlag(name, f.cmd.PersistentFlags().Lookup(name))
+}
+
 // Int adds a local flag that accepts an integer.
 func (f *Flagger) Int(name, shorthand string, value int, usage string) {
 	f.cmd.Flags().IntP(name, shorthand, value, usage)
@@ -5,19 +5,53 @@ import (
 	"os"
 )
 
+// ExitCode* constants contain the exit statuses used by HandleError.
+const (
+	ExitCodeError       = 1
+	ExitCodeAuth        = 2
+	ExitCodePermission  = 3
+	ExitCodeNotFound    = 4
+	ExitCodeRateLimited = 5
+)
+
+// ExitCodes maps error categories to the exit status used by HandleError.
+// Errors in categories that aren't in the map exit with ExitCodeError.
+var ExitCodes = map[string]int{
+	"auth":         ExitCodeAuth,
+	"permission":   ExitCodePermission,
+	"not_found":    ExitCodeNotFound,
+	"rate_limited": ExitCodeRateLimited,
+}
+
+// CategorizedError is the interface implemented by errors that belong to a
+// category, e.g. *qb.APIError.
+type CategorizedError interface {
+	error
+
+	// Category returns the category of the error, e.g. "not_found".
+	Category() string
+}
+
+// CodedError is the interface implemented by errors that carry a numeric
+// error code, e.g. *qb.APIError.
+type CodedError interface {
+	error
+
+	// ErrorCode returns the numeric error code.
+	ErrorCode() int
+}
+
 // ErrorResponse models an error response.
 type ErrorResponse struct {
-	Error  string `json:"error"`
-	Detail string `json:"detail,omitempty"`
+	Error    string `json:"error"`
+	Detail   string `json:"detail,omitempty"`
+	Code     int    `json:"code,omitempty"`
+	Category string `json:"category,omitempty"`
 }
 
-// HandleError prints an error message in JSON format to STDERR and exits with
-// a non-zero status code.
-func HandleError(err error, prefix string) {
-	if err == nil {
-		return
-	}
-
+// NewErrorResponse returns the ErrorResponse for err. The error's code and
+// category are included if it implements CodedError or CategorizedError.
+func NewErrorResponse(err error, prefix string) ErrorResponse {
 	resp := ErrorResponse{}
 	if prefix != "" {
 		resp.Error = prefix
@@ -26,6 +60,43 @@ func HandleError(err error, prefix string) {
 		resp.Error = err.Error()
 	}
 
+	for e := err; e != nil; e = unwrap(e) {
+		if ce, ok := e.(CodedError); ok && resp.Code == 0 {
+			resp.Code = ce.ErrorCode()
+		}
+		if ce, ok := e.(CategorizedError); ok && resp.Category == "" {
+			resp.Category = ce.Category()
+		}
+	}
+
+	return resp
+}
+
+// ExitCode returns the exit status for the error response.
+func (r ErrorResponse) ExitCode() int {
+	if c, ok := ExitCodes[r.Category]; ok {
+		return c
+	}
+	return ExitCodeError
+}
+
+// HandleError prints an error message in JSON format to STDERR and exits with
+// a non-zero status code. The status code depends on the error's category,
+// see ExitCodes.
+func HandleError(err error, prefix string) {
+	if err == nil {
+		return
+	}
+
+	resp := NewErrorResponse(err, prefix)
 	fmt.Fprintf(os.Stderr, "%s\n", FormatJSON(resp))
-	os.Exit(1)
+	os.Exit(resp.ExitCode())
+}
+
+// unwrap returns the error wrapped by err, or nil if it doesn't wrap one.
+func unwrap(err error) error {
+	if u, ok := err.(interface{ Unwrap() error }); ok {
+		return u.Unwrap()
+	}
+	return nil
 }
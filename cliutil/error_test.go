@@ -0,0 +1,40 @@
+package cliutil
+
+import (
+	"errors"
+	"testing"
+)
+
+type testAPIError struct {
+	code     int
+	category string
+}
+
+func (e testAPIError) Error() string    { return "api error" }
+func (e testAPIError) ErrorCode() int   { return e.code }
+func (e testAPIError) Category() string { return e.category }
+
+func TestNewErrorResponse(t *testing.T) {
+	tests := []struct {
+		err      error
+		prefix   string
+		expected ErrorResponse
+		exitCode int
+	}{
+		{errors.New("some error"), "", ErrorResponse{Error: "some error"}, ExitCodeError},
+		{errors.New("some error"), "error doing something", ErrorResponse{Error: "error doing something", Detail: "some error"}, ExitCodeError},
+		{testAPIError{4, "auth"}, "error", ErrorResponse{Error: "error", Detail: "api error", Code: 4, Category: "auth"}, ExitCodeAuth},
+		{testAPIError{31, "not_found"}, "", ErrorResponse{Error: "api error", Code: 31, Category: "not_found"}, ExitCodeNotFound},
+		{testAPIError{2, "api"}, "", ErrorResponse{Error: "api error", Code: 2, Category: "api"}, ExitCodeError},
+	}
+
+	for _, tt := range tests {
+		resp := NewErrorResponse(tt.err, tt.prefix)
+		if resp != tt.expected {
+			t.Errorf("expected %+v, got %+v", tt.expected, resp)
+		}
+		if resp.ExitCode() != tt.exitCode {
+			t.Errorf("%+v: expected exit code %v, got %v", resp, tt.exitCode, resp.ExitCode())
+		}
+	}
+}
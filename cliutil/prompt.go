@@ -0,0 +1,64 @@
+package cliutil
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// stdin is shared so that input buffered by one read isn't lost to the next.
+var stdin = bufio.NewReader(os.Stdin)
+
+// ReadLine reads a single line from STDIN with the trailing newline removed.
+func ReadLine() (string, error) {
+	line, err := stdin.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	return strings.TrimRight(line, "\r\n"), err
+}
+
+// Prompt writes the prompt to STDERR and reads the response from STDIN.
+// STDERR is used so that the prompt doesn't pollute the command's output.
+func Prompt(prompt string) (string, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	return ReadLine()
+}
+
+// Confirm writes the prompt to STDERR and returns whether the response read
+// from the terminal is "y" or "yes". An error is returned if STDIN isn't a
+// terminal, so that destructive actions aren't confirmed by piped input.
+func Confirm(prompt string) (bool, error) {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return false, errors.New("cannot prompt for confirmation, STDIN is not a terminal")
+	}
+
+	resp, err := Prompt(prompt + " [y/N] ")
+	if err != nil {
+		return false, err
+	}
+
+	resp = strings.ToLower(strings.TrimSpace(resp))
+	return resp == "y" || resp == "yes", nil
+}
+
+// PromptPassword writes the prompt to STDERR and reads the response from the
+// terminal without echoing it. An error is returned if STDIN isn't a
+// terminal.
+func PromptPassword(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", errors.New("cannot prompt for password, STDIN is not a terminal")
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+	b, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+
+	return string(b), err
+}
@@ -2,9 +2,193 @@ package cliutil
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"reflect"
+
+	jmespath "github.com/jmespath/go-jmespath"
+)
+
+// Format* constants contain the names of the built-in output formats.
+const (
+	FormatNameCSV      = "csv"
+	FormatNameJSON     = "json"
+	FormatNameNDJSON   = "ndjson"
+	FormatNameTable    = "table"
+	FormatNameTemplate = "template"
+	FormatNameYAML     = "yaml"
 )
 
+// RenderOptions contains the options that control how command output is
+// rendered.
+type RenderOptions struct {
+
+	// Filter is a JMESPath expression that is evaluated against the output
+	// prior to it being rendered.
+	Filter string
+
+	// Raw flags whether to write the raw API response instead of JSON. The
+	// value being rendered must implement RawResponder.
+	Raw bool
+
+	// Batch flags whether to render compact, line-oriented JSON. Each element
+	// of a slice is rendered on its own line so that the output can be piped
+	// to other commands.
+	Batch bool
+
+	// Format is the name of the registered Renderer used to render the
+	// output, see RegisterRenderer.
+	Format string
+
+	// Template is the Go text/template used by the "template" format.
+	Template string
+}
+
+// FormatName returns the name of the output format. If a format isn't set,
+// "template" is returned if a template is set, "ndjson" is returned in batch
+// mode, otherwise "json" is returned.
+func (o RenderOptions) FormatName() string {
+	switch {
+	case o.Format != "":
+		return o.Format
+	case o.Template != "":
+		return FormatNameTemplate
+	case o.Batch:
+		return FormatNameNDJSON
+	default:
+		return FormatNameJSON
+	}
+}
+
+// RawResponder is the interface implemented by values that retain the raw
+// response returned by the API.
+type RawResponder interface {
+	RawResponse() []byte
+}
+
+// Render writes v to STDOUT according to the passed options.
+func Render(v interface{}, opts RenderOptions) error {
+	return Fprint(os.Stdout, v, opts)
+}
+
+// Fprint writes v to w according to the passed options.
+func Fprint(w io.Writer, v interface{}, opts RenderOptions) error {
+	if opts.Raw {
+		return FprintRaw(w, v)
+	}
+
+	r, err := GetRenderer(opts.FormatName())
+	if err != nil {
+		return err
+	}
+
+	v, err = Filter(v, opts.Filter)
+	if err != nil {
+		return err
+	}
+
+	return r.Render(w, v, opts)
+}
+
+// FprintBatch writes v to w as compact JSON. If v is a slice or array, each
+// element is written on its own line.
+func FprintBatch(w io.Writer, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return fprintCompactJSON(w, v)
+	}
+
+	for i := 0; i < rv.Len(); i++ {
+		if err := fprintCompactJSON(w, rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fprintCompactJSON writes v to w as JSON on a single line.
+func fprintCompactJSON(w io.Writer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
+
+// FprintRaw writes the raw API response retained by v to w.
+func FprintRaw(w io.Writer, v interface{}) error {
+	r, ok := v.(RawResponder)
+	if !ok {
+		return errors.New("raw output not supported by this command")
+	}
+
+	b := r.RawResponse()
+	if _, err := w.Write(b); err != nil {
+		return err
+	}
+
+	// Ensure the output is terminated with a newline.
+	if len(b) > 0 && b[len(b)-1] != '\n' {
+		_, err := fmt.Fprintln(w)
+		return err
+	}
+
+	return nil
+}
+
+// CompileFilter validates a JMESPath expression.
+func CompileFilter(expr string) (*jmespath.JMESPath, error) {
+	jp, err := jmespath.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JMESPath expression %q: %s", expr, err)
+	}
+	return jp, nil
+}
+
+// Filter evaluates the JMESPath expression against v and returns the result.
+// v is returned as-is if the expression is empty. The expression is evaluated
+// against the normalized value, see Normalize, so that the expression uses
+// the same keys that are rendered, e.g. "records[].record_id".
+func Filter(v interface{}, expr string) (interface{}, error) {
+	if expr == "" {
+		return v, nil
+	}
+
+	jp, err := CompileFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := Normalize(v)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := jp.Search(data)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating JMESPath expression %q: %s", expr, err)
+	}
+
+	return result, nil
+}
+
+// Normalize round-trips v through JSON so that structs are converted to the
+// maps, slices, and scalars that are rendered as JSON.
+func Normalize(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var data interface{}
+	err = json.Unmarshal(b, &data)
+	return data, err
+}
+
 // FormatJSON returns pretty-printed JSON as a string.
 func FormatJSON(v interface{}) string {
 	b, err := json.MarshalIndent(v, "", "    ")
@@ -0,0 +1,127 @@
+package cliutil_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+)
+
+type testRecord struct {
+	ID     int               `json:"record_id"`
+	Fields map[string]string `json:"fields"`
+}
+
+type testOutput struct {
+	Records []testRecord `json:"records"`
+}
+
+func newTestOutput() testOutput {
+	return testOutput{
+		Records: []testRecord{
+			{ID: 1, Fields: map[string]string{"7": "Find me"}},
+			{ID: 2, Fields: map[string]string{"7": "Find me too"}},
+		},
+	}
+}
+
+func TestFilter(t *testing.T) {
+	v, err := cliutil.Filter(newTestOutput(), "records[].record_id")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ids, ok := v.([]interface{})
+	if !ok {
+		t.Fatalf("expected a slice, got %T", v)
+	}
+	if len(ids) != 2 {
+		t.Fatalf("expected 2 IDs, got %v", len(ids))
+	}
+	if ids[0] != float64(1) {
+		t.Errorf("expected '1', got '%v'", ids[0])
+	}
+}
+
+func TestFilterEmpty(t *testing.T) {
+	out := newTestOutput()
+	v, err := cliutil.Filter(out, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, ok := v.(testOutput); !ok {
+		t.Errorf("expected the value to be returned as-is, got %T", v)
+	}
+}
+
+func TestFilterInvalid(t *testing.T) {
+	if _, err := cliutil.Filter(newTestOutput(), "records[?"); err == nil {
+		t.Error("expected an error for an invalid expression")
+	}
+}
+
+func TestFprint(t *testing.T) {
+	var buf bytes.Buffer
+	opts := cliutil.RenderOptions{Filter: `records[0].fields."7"`}
+	if err := cliutil.Fprint(&buf, newTestOutput(), opts); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := "\"Find me\"\n"
+	if buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
+
+type testRawOutput struct {
+	raw []byte
+}
+
+func (o testRawOutput) RawResponse() []byte { return o.raw }
+
+func TestFprintRaw(t *testing.T) {
+	var buf bytes.Buffer
+	out := testRawOutput{raw: []byte("<qdbapi></qdbapi>")}
+	if err := cliutil.Fprint(&buf, out, cliutil.RenderOptions{Raw: true}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := "<qdbapi></qdbapi>\n"
+	if buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestFprintRawUnsupported(t *testing.T) {
+	var buf bytes.Buffer
+	if err := cliutil.Fprint(&buf, newTestOutput(), cliutil.RenderOptions{Raw: true}); err == nil {
+		t.Error("expected an error for a value that does not retain the raw response")
+	}
+}
+
+func TestFprintBatch(t *testing.T) {
+	var buf bytes.Buffer
+	opts := cliutil.RenderOptions{Batch: true, Filter: "records"}
+	if err := cliutil.Fprint(&buf, newTestOutput(), opts); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"fields":{"7":"Find me"},"record_id":1}` + "\n" +
+		`{"fields":{"7":"Find me too"},"record_id":2}` + "\n"
+	if buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestFprintBatchObject(t *testing.T) {
+	var buf bytes.Buffer
+	out := testRecord{ID: 1, Fields: map[string]string{"7": "Find me"}}
+	if err := cliutil.Fprint(&buf, out, cliutil.RenderOptions{Batch: true}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"record_id":1,"fields":{"7":"Find me"}}` + "\n"
+	if buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
@@ -0,0 +1,157 @@
+package cliutil
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Renderer is the interface implemented by output formats.
+type Renderer interface {
+
+	// Render writes v to w. v is the value being rendered, or the result of
+	// the JMESPath filter if one was passed.
+	Render(w io.Writer, v interface{}, opts RenderOptions) error
+}
+
+// RendererFunc is an adapter that allows ordinary functions to be used as
+// a Renderer.
+type RendererFunc func(w io.Writer, v interface{}, opts RenderOptions) error
+
+// Render implements Renderer.Render by calling f(w, v, opts).
+func (f RendererFunc) Render(w io.Writer, v interface{}, opts RenderOptions) error {
+	return f(w, v, opts)
+}
+
+var (
+	renderersMu sync.RWMutex
+	renderers   = make(map[string]Renderer)
+)
+
+func init() {
+	RegisterRenderer(FormatNameCSV, RendererFunc(renderCSV))
+	RegisterRenderer(FormatNameJSON, RendererFunc(renderJSON))
+	RegisterRenderer(FormatNameNDJSON, RendererFunc(renderNDJSON))
+	RegisterRenderer(FormatNameTable, RendererFunc(renderTable))
+	RegisterRenderer(FormatNameTemplate, RendererFunc(renderTemplate))
+	RegisterRenderer(FormatNameYAML, RendererFunc(renderYAML))
+}
+
+// RegisterRenderer makes a Renderer available by the passed name. Registering
+// a renderer with the same name as an existing one replaces it.
+func RegisterRenderer(name string, r Renderer) {
+	renderersMu.Lock()
+	defer renderersMu.Unlock()
+	renderers[name] = r
+}
+
+// GetRenderer returns the Renderer registered with the passed name.
+func GetRenderer(name string) (Renderer, error) {
+	renderersMu.RLock()
+	defer renderersMu.RUnlock()
+
+	r, ok := renderers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown output format %q, expected one of %s", name, strings.Join(rendererNames(), ", "))
+	}
+	return r, nil
+}
+
+// RendererNames returns the sorted names of the registered renderers.
+func RendererNames() []string {
+	renderersMu.RLock()
+	defer renderersMu.RUnlock()
+	return rendererNames()
+}
+
+// rendererNames returns the sorted names of the registered renderers. The
+// caller must hold renderersMu.
+func rendererNames() []string {
+	names := make([]string, 0, len(renderers))
+	for name := range renderers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// renderJSON writes v to w as pretty-printed JSON.
+func renderJSON(w io.Writer, v interface{}, opts RenderOptions) error {
+	_, err := fmt.Fprintln(w, FormatJSON(v))
+	return err
+}
+
+// renderNDJSON writes v to w as newline delimited JSON, see FprintBatch.
+func renderNDJSON(w io.Writer, v interface{}, opts RenderOptions) error {
+	return FprintBatch(w, v)
+}
+
+// renderYAML writes v to w as YAML using the same keys as JSON.
+func renderYAML(w io.Writer, v interface{}, opts RenderOptions) error {
+	data, err := Normalize(v)
+	if err != nil {
+		return err
+	}
+
+	b, err := yaml.Marshal(integers(data))
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(b)
+	return err
+}
+
+// CompileTemplate parses a Go text/template used by the "template" format.
+func CompileTemplate(text string) (*template.Template, error) {
+	tpl, err := template.New("output").Funcs(template.FuncMap{
+		"json": func(v interface{}) string { return FormatJSON(v) },
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %s", err)
+	}
+	return tpl, nil
+}
+
+// renderTemplate executes the template against v. The template is executed
+// against the normalized value so that it uses the same keys as JSON, e.g.
+// '{{range .records}}{{.record_id}}{{"\n"}}{{end}}'.
+func renderTemplate(w io.Writer, v interface{}, opts RenderOptions) error {
+	tpl, err := CompileTemplate(opts.Template)
+	if err != nil {
+		return err
+	}
+
+	data, err := Normalize(v)
+	if err != nil {
+		return err
+	}
+
+	return tpl.Execute(w, integers(data))
+}
+
+// integers converts float64 values in normalized data that are whole numbers
+// to int64 so that IDs and timestamps aren't rendered in scientific notation.
+func integers(v interface{}) interface{} {
+	switch t := v.(type) {
+	case float64:
+		if t == math.Trunc(t) && math.Abs(t) < 1<<53 {
+			return int64(t)
+		}
+	case []interface{}:
+		for k := range t {
+			t[k] = integers(t[k])
+		}
+	case map[string]interface{}:
+		for k := range t {
+			t[k] = integers(t[k])
+		}
+	}
+	return v
+}
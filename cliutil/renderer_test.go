@@ -0,0 +1,88 @@
+package cliutil_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+)
+
+func TestRenderFormats(t *testing.T) {
+	tests := []struct {
+		opts cliutil.RenderOptions
+		want string
+	}{
+		{
+			cliutil.RenderOptions{Format: cliutil.FormatNameTable},
+			"fields.7     record_id\nFind me      1\nFind me too  2\n",
+		},
+		{
+			cliutil.RenderOptions{Format: cliutil.FormatNameCSV},
+			"fields.7,record_id\nFind me,1\nFind me too,2\n",
+		},
+		{
+			cliutil.RenderOptions{Format: cliutil.FormatNameYAML, Filter: "records[0]"},
+			"fields:\n  \"7\": Find me\nrecord_id: 1\n",
+		},
+		{
+			cliutil.RenderOptions{Template: `{{range .records}}{{.record_id}},{{end}}`},
+			"1,2,",
+		},
+		{
+			cliutil.RenderOptions{Format: cliutil.FormatNameNDJSON, Filter: "records[].record_id"},
+			"1\n2\n",
+		},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := cliutil.Fprint(&buf, newTestOutput(), test.opts); err != nil {
+			t.Fatalf("%+v: unexpected error: %s", test.opts, err)
+		}
+		if buf.String() != test.want {
+			t.Errorf("%+v: expected %q, got %q", test.opts, test.want, buf.String())
+		}
+	}
+}
+
+func TestRenderUnknownFormat(t *testing.T) {
+	var buf bytes.Buffer
+	opts := cliutil.RenderOptions{Format: "unknown"}
+	if err := cliutil.Fprint(&buf, newTestOutput(), opts); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
+
+func TestFormatName(t *testing.T) {
+	tests := []struct {
+		opts cliutil.RenderOptions
+		want string
+	}{
+		{cliutil.RenderOptions{}, cliutil.FormatNameJSON},
+		{cliutil.RenderOptions{Batch: true}, cliutil.FormatNameNDJSON},
+		{cliutil.RenderOptions{Template: "{{.}}"}, cliutil.FormatNameTemplate},
+		{cliutil.RenderOptions{Batch: true, Format: cliutil.FormatNameTable}, cliutil.FormatNameTable},
+	}
+
+	for _, test := range tests {
+		if got := test.opts.FormatName(); got != test.want {
+			t.Errorf("%+v: expected '%s', got '%s'", test.opts, test.want, got)
+		}
+	}
+}
+
+func TestRegisterRenderer(t *testing.T) {
+	cliutil.RegisterRenderer("test", cliutil.RendererFunc(func(w io.Writer, v interface{}, opts cliutil.RenderOptions) error {
+		_, err := io.WriteString(w, "test")
+		return err
+	}))
+
+	var buf bytes.Buffer
+	if err := cliutil.Fprint(&buf, newTestOutput(), cliutil.RenderOptions{Format: "test"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if buf.String() != "test" {
+		t.Errorf("expected 'test', got %q", buf.String())
+	}
+}
@@ -0,0 +1,49 @@
+package cliutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ListWriter incrementally writes a pretty-printed JSON object containing a
+// single list, e.g. {"records": [...]}, so that large result sets can be
+// rendered as they are retrieved instead of being buffered in memory. The
+// output is identical to FormatJSON for the equivalent value.
+type ListWriter struct {
+	w   io.Writer
+	key string
+	n   int
+}
+
+// NewListWriter returns a ListWriter that writes the list keyed by key to w.
+func NewListWriter(w io.Writer, key string) *ListWriter {
+	return &ListWriter{w: w, key: key}
+}
+
+// Write writes v as the next element in the list.
+func (l *ListWriter) Write(v interface{}) error {
+	b, err := json.MarshalIndent(v, "        ", "    ")
+	if err != nil {
+		return err
+	}
+
+	if l.n == 0 {
+		_, err = fmt.Fprintf(l.w, "{\n    %q: [\n        %s", l.key, b)
+	} else {
+		_, err = fmt.Fprintf(l.w, ",\n        %s", b)
+	}
+
+	l.n++
+	return err
+}
+
+// Close terminates the list and the object containing it.
+func (l *ListWriter) Close() (err error) {
+	if l.n == 0 {
+		_, err = fmt.Fprintf(l.w, "{\n    %q: []\n}\n", l.key)
+	} else {
+		_, err = fmt.Fprint(l.w, "\n    ]\n}\n")
+	}
+	return
+}
@@ -0,0 +1,40 @@
+package cliutil_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+)
+
+func TestListWriter(t *testing.T) {
+	out := newTestOutput()
+
+	var buf bytes.Buffer
+	lw := cliutil.NewListWriter(&buf, "records")
+	for _, r := range out.Records {
+		if err := lw.Write(r); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if err := lw.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := cliutil.FormatJSON(out) + "\n"
+	if buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
+
+func TestListWriterEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := cliutil.NewListWriter(&buf, "records").Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := cliutil.FormatJSON(testOutput{Records: []testRecord{}}) + "\n"
+	if buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
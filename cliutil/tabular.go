@@ -0,0 +1,180 @@
+package cliutil
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Tabular is the interface implemented by values that define how they are
+// rendered as rows and columns by the "table" and "csv" formats. Values that
+// don't implement Tabular are converted by NewTable.
+type Tabular interface {
+	Table() (header []string, rows [][]string)
+}
+
+// NewTable returns the header and rows used to render v in a tabular format.
+//
+// If v implements Tabular, its Table method is used. Otherwise v is
+// normalized and objects that contain a single list or object are unwrapped,
+// e.g. {"records": [...]} is treated as the list of records. A list of
+// objects is rendered with a row per object and a column per key, where
+// nested objects are flattened into "parent.child" columns. A single object
+// is rendered as one row, and scalars are rendered in a "value" column.
+func NewTable(v interface{}) (header []string, rows [][]string, err error) {
+	if t, ok := v.(Tabular); ok {
+		header, rows = t.Table()
+		return
+	}
+
+	data, err := Normalize(v)
+	if err != nil {
+		return
+	}
+
+	data = unwrapTable(data)
+	switch t := data.(type) {
+	case []interface{}:
+		header, rows = listTable(t)
+	case map[string]interface{}:
+		header, rows = listTable([]interface{}{t})
+	default:
+		header = []string{"value"}
+		rows = [][]string{{formatCell(t)}}
+	}
+
+	return
+}
+
+// unwrapTable descends into objects that contain a single list or object.
+func unwrapTable(data interface{}) interface{} {
+	for {
+		m, ok := data.(map[string]interface{})
+		if !ok || len(m) != 1 {
+			return data
+		}
+
+		var child interface{}
+		for _, child = range m {
+		}
+
+		switch child.(type) {
+		case []interface{}, map[string]interface{}:
+			data = child
+		default:
+			return data
+		}
+	}
+}
+
+// listTable returns the header and rows for a list. If every element is an
+// object, there is a column for each key, otherwise each element is rendered
+// in a "value" column.
+func listTable(list []interface{}) (header []string, rows [][]string) {
+	flat := make([]map[string]string, len(list))
+	keys := make(map[string]bool)
+
+	for k, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			header = []string{"value"}
+			rows = make([][]string, len(list))
+			for k, item := range list {
+				rows[k] = []string{formatCell(item)}
+			}
+			return
+		}
+
+		flat[k] = make(map[string]string)
+		flatten("", m, flat[k])
+		for key := range flat[k] {
+			keys[key] = true
+		}
+	}
+
+	for key := range keys {
+		header = append(header, key)
+	}
+	sort.Strings(header)
+
+	rows = make([][]string, len(flat))
+	for k, m := range flat {
+		rows[k] = make([]string, len(header))
+		for i, key := range header {
+			rows[k][i] = m[key]
+		}
+	}
+
+	return
+}
+
+// flatten converts nested objects to "parent.child" keys in dst.
+func flatten(prefix string, m map[string]interface{}, dst map[string]string) {
+	for key, value := range m {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		if child, ok := value.(map[string]interface{}); ok {
+			flatten(key, child, dst)
+		} else {
+			dst[key] = formatCell(value)
+		}
+	}
+}
+
+// formatCell formats a normalized value as a cell.
+func formatCell(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case bool:
+		return strconv.FormatBool(t)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	default:
+		b, _ := json.Marshal(t)
+		return string(b)
+	}
+}
+
+// renderTable writes v to w as a human readable table with aligned columns.
+func renderTable(w io.Writer, v interface{}, opts RenderOptions) error {
+	header, rows, err := NewTable(v)
+	if err != nil {
+		return err
+	}
+
+	// Tabs and newlines in cells would break the alignment.
+	clean := strings.NewReplacer("\t", " ", "\r", " ", "\n", " ")
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, row := range append([][]string{header}, rows...) {
+		for k := range row {
+			row[k] = clean.Replace(row[k])
+		}
+		if _, err := fmt.Fprintln(tw, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
+
+// renderCSV writes v to w in CSV format with a header row.
+func renderCSV(w io.Writer, v interface{}, opts RenderOptions) error {
+	header, rows, err := NewTable(v)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Write(header)
+	cw.WriteAll(rows)
+	return cw.Error()
+}
@@ -0,0 +1,56 @@
+package cliutil_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+)
+
+type testTabular struct{}
+
+func (testTabular) Table() ([]string, [][]string) {
+	return []string{"Name"}, [][]string{{"Find me"}}
+}
+
+func TestNewTable(t *testing.T) {
+	tests := []struct {
+		v      interface{}
+		header []string
+		rows   [][]string
+	}{
+		{
+			testTabular{},
+			[]string{"Name"},
+			[][]string{{"Find me"}},
+		},
+		{
+			map[string]interface{}{"records": []int{1549648909242, 2}},
+			[]string{"value"},
+			[][]string{{"1549648909242"}, {"2"}},
+		},
+		{
+			map[string]interface{}{"fields": map[string]string{"7": "Match Field"}},
+			[]string{"7"},
+			[][]string{{"Match Field"}},
+		},
+		{
+			"Find me",
+			[]string{"value"},
+			[][]string{{"Find me"}},
+		},
+	}
+
+	for _, test := range tests {
+		header, rows, err := cliutil.NewTable(test.v)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %s", test.v, err)
+		}
+		if !reflect.DeepEqual(header, test.header) {
+			t.Errorf("%v: expected header %v, got %v", test.v, test.header, header)
+		}
+		if !reflect.DeepEqual(rows, test.rows) {
+			t.Errorf("%v: expected rows %v, got %v", test.v, test.rows, rows)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package cliutil
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/signal"
@@ -13,7 +14,7 @@ func EventListener() <-chan bool {
 	shutdown := make(chan bool)
 
 	go func() {
-		ch := make(chan os.Signal)
+		ch := make(chan os.Signal, 1)
 		signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
 
 		for {
@@ -28,3 +29,20 @@ func EventListener() <-chan bool {
 
 	return shutdown
 }
+
+// SignalContext returns a copy of parent that is canceled when a SIGINT or
+// SIGTERM signal is received, see EventListener. The process exits if a
+// second signal is received after the context is canceled.
+func SignalContext(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+	shutdown := EventListener()
+
+	go func() {
+		<-shutdown
+		cancel()
+		<-shutdown
+		os.Exit(1)
+	}()
+
+	return ctx, cancel
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+)
+
+var appCmd = &cobra.Command{
+	Use:   "app",
+	Short: "Commands that act on applications",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(appCmd)
+}
+
+// AppInfoOutput models the output that describes an application.
+type AppInfoOutput struct {
+	Name                   string `json:"name"`
+	AppID                  string `json:"app_id"`
+	NumRecords             int    `json:"num_records"`
+	CreatedTime            string `json:"created_time,omitempty"`
+	LastModifiedTime       string `json:"last_modified_time,omitempty"`
+	LastRecordModifiedTime string `json:"last_record_modified_time,omitempty"`
+	ManagerName            string `json:"manager_name,omitempty"`
+	AncestorAppID          string `json:"ancestor_app_id,omitempty"`
+	OldestAncestorAppID    string `json:"oldest_ancestor_app_id,omitempty"`
+}
+
+// newAppInfoOutput returns an AppInfoOutput for the application.
+func newAppInfoOutput(appID string, info qb.GetDBInfoOutput) AppInfoOutput {
+	return AppInfoOutput{
+		Name:                   info.Name,
+		AppID:                  appID,
+		NumRecords:             info.NumRecords,
+		CreatedTime:            formatTimestamp(info.CreatedTime),
+		LastModifiedTime:       formatTimestamp(info.LastModifiedTime),
+		LastRecordModifiedTime: formatTimestamp(info.LastRecordModifiedTime),
+		ManagerName:            info.ManagerName,
+	}
+}
+
+// formatTimestamp formats a timestamp returned by Quick Base, which is the
+// number of milliseconds since the epoch, as RFC 3339. Zero values are
+// formatted as an empty string.
+func formatTimestamp(ms int64) string {
+	if ms == 0 {
+		return ""
+	}
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var appCloneCfg *viper.Viper
+
+var appCloneCmd = &cobra.Command{
+	Use:   "clone [NAME]",
+	Short: "Clones an application",
+	Long: `Copies the application passed via --app-id to a new application named NAME
+and prints the new application's dbid. Only the schema is copied unless
+--keep-data is passed.`,
+	Args: appCloneCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.CloneDatabaseInput{
+			AppID:         globalCfg.AppID(),
+			Name:          args[0],
+			Description:   appCloneCfg.GetString("description"),
+			KeepData:      qb.Bool(appCloneCfg.GetBool("keep-data")),
+			ExcludeFiles:  qb.Bool(appCloneCfg.GetBool("exclude-files")),
+			UsersAndRoles: qb.Bool(appCloneCfg.GetBool("users-and-roles")),
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.CloneDatabaseWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		render(output)
+	},
+}
+
+func init() {
+	appCmd.AddCommand(appCloneCmd)
+	appCloneCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(appCloneCmd, appCloneCfg)
+	flags.String("description", "d", "", "the new application's description")
+	flags.Bool("exclude-files", "x", false, "don't copy file attachments, ignored unless --keep-data is passed")
+	flags.Bool("keep-data", "k", false, "copy the application's records")
+	flags.Bool("users-and-roles", "u", false, "copy the application's users and roles")
+}
+
+func appCloneCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if len(args) < 1 {
+		return errors.New("missing required argument: [NAME]")
+	}
+
+	return nil
+}
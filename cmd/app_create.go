@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var appCreateCfg *viper.Viper
+
+var appCreateCmd = &cobra.Command{
+	Use:   "create [NAME]",
+	Short: "Creates an application",
+	Long: `Creates an empty application and prints its dbid, which can be passed to
+--app-id, e.g. to create tables with "table create".`,
+	Args: appCreateCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.CreateDatabaseInput{
+			Name:           args[0],
+			Description:    appCreateCfg.GetString("description"),
+			CreateAppToken: qb.Bool(appCreateCfg.GetBool("create-app-token")),
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.CreateDatabaseWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		render(output)
+	},
+}
+
+func init() {
+	appCmd.AddCommand(appCreateCmd)
+	appCreateCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(appCreateCmd, appCreateCfg)
+	flags.Bool("create-app-token", "", false, "create an app token for the application")
+	flags.String("description", "d", "", "the application's description")
+}
+
+func appCreateCmdValidate(cmd *cobra.Command, args []string) error {
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if len(args) < 1 {
+		return errors.New("missing required argument: [NAME]")
+	}
+
+	return nil
+}
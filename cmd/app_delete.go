@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var appDeleteCfg *viper.Viper
+
+var appDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Deletes an application",
+	Long: `Deletes the application passed via --app-id along with all of its tables and
+data, which cannot be recovered. The application is only deleted after
+confirming at the prompt or if --yes is passed.`,
+	Args: appDeleteCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.DeleteDatabaseInput{ID: globalCfg.AppID()}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		if !appDeleteCfg.GetBool("yes") {
+			info, err := client.GetDBInfoWithContext(ctx, &qb.GetDBInfoInput{ID: input.ID})
+			cliutil.HandleError(err, "error executing request")
+
+			prompt := fmt.Sprintf("Permanently delete application %q (%s) and all of its data?", info.Name, input.ID)
+			ok, err := cliutil.Confirm(prompt)
+			cliutil.HandleError(err, "error confirming deletion, pass --yes to skip confirmation")
+			if !ok {
+				cliutil.HandleError(errors.New("deletion canceled"), "")
+			}
+		}
+
+		output, err := client.DeleteDatabaseWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, AppDeleteOutput{
+			UserData: output.UserData,
+			AppID:    input.ID,
+		})
+	},
+}
+
+func init() {
+	appCmd.AddCommand(appDeleteCmd)
+	appDeleteCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(appDeleteCmd, appDeleteCfg)
+	flags.Bool("yes", "y", false, "delete the application without prompting for confirmation")
+}
+
+func appDeleteCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	return globalCfg.Validate()
+}
+
+// AppDeleteOutput models the output printed after an application is deleted.
+type AppDeleteOutput struct {
+	UserData string `json:"user_data,omitempty"`
+	AppID    string `json:"app_id"`
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var appFindCfg *viper.Viper
+
+var appFindCmd = &cobra.Command{
+	Use:   "find [NAME]",
+	Short: "Finds an application by name",
+	Long: `Finds an application by name and prints its dbid, which can be passed to
+--app-id, along with its record count and last-modified times.`,
+	Args: appFindCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.FindDBByNameInput{
+			Name:        args[0],
+			ParentsOnly: true,
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		output, err := client.FindDBByNameWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		info, err := client.GetDBInfoWithContext(ctx, &qb.GetDBInfoInput{ID: output.ID})
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, newAppInfoOutput(output.ID, info))
+	},
+}
+
+func init() {
+	appCmd.AddCommand(appFindCmd)
+	appFindCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+}
+
+func appFindCmdValidate(cmd *cobra.Command, args []string) error {
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if len(args) < 1 {
+		return errors.New("missing required argument: [NAME]")
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var appInfoCfg *viper.Viper
+
+var appInfoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Describes an application",
+	Long: `Describes the application passed via --app-id, including its name, record
+count, last-modified times, and the application it was copied from, if any.`,
+	Args: appInfoCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		appID := globalCfg.AppID()
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		info, err := client.GetDBInfoWithContext(ctx, &qb.GetDBInfoInput{ID: appID})
+		cliutil.HandleError(err, "error executing request")
+
+		ancestors, err := client.GetAncestorInfoWithContext(ctx, &qb.GetAncestorInfoInput{AppID: appID})
+		if !qb.IsNotFound(err) {
+			cliutil.HandleError(err, "error executing request")
+		}
+
+		v := newAppInfoOutput(appID, info)
+		v.AncestorAppID = ancestors.AncestorAppID
+		v.OldestAncestorAppID = ancestors.OldestAncestorAppID
+		renderResponse(info, v)
+	},
+}
+
+func init() {
+	appCmd.AddCommand(appInfoCmd)
+	appInfoCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+}
+
+func appInfoCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	return globalCfg.Validate()
+}
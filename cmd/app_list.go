@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var appListCfg *viper.Viper
+
+var appListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists applications",
+	Long: `Lists the applications the user has access to by name and dbid.
+
+Pass --detailed to include each application's record count and last-modified
+times, which requires a request per application.`,
+	Args: appListCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.GrantedDBsInput{
+			AdminOnly: qb.Bool(appListCfg.GetBool("admin-only")),
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		output, err := client.GrantedDBsWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		if !appListCfg.GetBool("detailed") {
+			apps := make([]AppListOutputApp, len(output.Databases))
+			for k, db := range output.Databases {
+				apps[k] = AppListOutputApp{Name: db.Name, AppID: db.ID}
+			}
+			renderResponse(output, AppListOutput{Apps: apps})
+			return
+		}
+
+		apps := make([]AppInfoOutput, len(output.Databases))
+		for k, db := range output.Databases {
+			info, err := client.GetDBInfoWithContext(ctx, &qb.GetDBInfoInput{ID: db.ID})
+			cliutil.HandleError(err, "error executing request")
+			apps[k] = newAppInfoOutput(db.ID, info)
+		}
+		renderResponse(output, AppListDetailedOutput{Apps: apps})
+	},
+}
+
+func init() {
+	appCmd.AddCommand(appListCmd)
+	appListCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(appListCmd, appListCfg)
+	flags.Bool("admin-only", "a", false, "only list applications the user administers")
+	flags.Bool("detailed", "d", false, "include each application's record count and last-modified times")
+}
+
+func appListCmdValidate(cmd *cobra.Command, args []string) error {
+	return globalCfg.Validate()
+}
+
+// AppListOutput models the output that lists applications.
+type AppListOutput struct {
+	Apps []AppListOutputApp `json:"apps"`
+}
+
+// AppListOutputApp models an application in AppListOutput.
+type AppListOutputApp struct {
+	Name  string `json:"name"`
+	AppID string `json:"app_id"`
+}
+
+// AppListDetailedOutput models the output that lists applications and their
+// record counts and last-modified times.
+type AppListDetailedOutput struct {
+	Apps []AppInfoOutput `json:"apps"`
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+)
+
+var appRenameCmd = &cobra.Command{
+	Use:   "rename [NAME]",
+	Short: "Renames an application",
+	Long: `Renames the application passed via --app-id. Only the application's name is
+changed, so its dbid and the dbids of its tables stay the same.`,
+	Args: appRenameCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.RenameAppInput{
+			AppID: globalCfg.AppID(),
+			Name:  args[0],
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.RenameAppWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, AppRenameOutput{
+			UserData: output.UserData,
+			AppID:    input.AppID,
+			Name:     input.Name,
+		})
+	},
+}
+
+func init() {
+	appCmd.AddCommand(appRenameCmd)
+}
+
+func appRenameCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if len(args) < 1 {
+		return errors.New("missing required argument: [NAME]")
+	}
+
+	return nil
+}
+
+// AppRenameOutput models the output printed after an application is renamed.
+type AppRenameOutput struct {
+	UserData string `json:"user_data,omitempty"`
+	AppID    string `json:"app_id"`
+	Name     string `json:"name"`
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+)
+
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Commands that manage the cached ticket",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(authCmd)
+}
+
+// newAuthOutput returns an AuthOutput.
+func newAuthOutput(info qb.TicketInfo, file string) AuthOutput {
+	out := AuthOutput{
+		UserID:     info.UserID,
+		Username:   info.Username,
+		TicketFile: file,
+		Expired:    info.Expired(),
+	}
+
+	if !info.Expires.IsZero() {
+		out.Expires = &info.Expires
+		if !out.Expired {
+			out.ExpiresIn = time.Until(info.Expires).Round(time.Second).String()
+		}
+	}
+
+	return out
+}
+
+// AuthOutput models the output that prints who is logged in and for how long.
+type AuthOutput struct {
+	UserID     string     `json:"user_id,omitempty"`
+	Username   string     `json:"username,omitempty"`
+	TicketFile string     `json:"ticket_file"`
+	Expires    *time.Time `json:"expires,omitempty"`
+	ExpiresIn  string     `json:"expires_in,omitempty"`
+	Expired    bool       `json:"expired"`
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var authLoginCfg *viper.Viper
+
+var authLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Creates a ticket and caches it in the ticket file",
+	Long: `Calls API_Authenticate to create a ticket and caches it in the ticket file so
+that it is used to authenticate subsequent commands.
+
+The password is prompted for without being echoed. Pass --password-stdin to
+read the password from STDIN instead, which is useful in CI environments.`,
+	Args: authLoginCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		var err error
+
+		username := authLoginCfg.GetString("username")
+		if username == "" {
+			username, err = cliutil.Prompt("Username: ")
+			cliutil.HandleError(err, "error reading username")
+		}
+
+		var password string
+		if authLoginCfg.GetBool("password-stdin") {
+			password, err = cliutil.ReadLine()
+		} else {
+			password, err = cliutil.PromptPassword("Password: ")
+		}
+		cliutil.HandleError(err, "error reading password")
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		file := globalCfg.TicketFile()
+		output, err := client.NewTicketWithContext(ctx, file, username, password, authLoginCfg.GetInt("hours"))
+		if qb.IsTicketFileErr(err) {
+			cliutil.HandleError(err, "error writing ticket file")
+		}
+		cliutil.HandleError(err, "error executing request")
+
+		info := qb.TicketInfo{
+			Ticket:   output.Ticket,
+			UserID:   output.UserID,
+			Username: output.Username,
+			Expires:  output.Expires,
+		}
+
+		renderResponse(output, newAuthOutput(info, file))
+	},
+}
+
+func init() {
+	authCmd.AddCommand(authLoginCmd)
+	authLoginCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(authLoginCmd, authLoginCfg)
+	flags.Int("hours", "H", qb.TicketHours, "number of hours until the ticket expires")
+	flags.Bool("password-stdin", "p", false, "read the password from STDIN")
+	flags.String("username", "u", "", "username or email address, prompted for if not passed")
+}
+
+func authLoginCmdValidate(cmd *cobra.Command, args []string) error {
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if authLoginCfg.GetInt("hours") <= 0 {
+		return errors.New("hours option invalid: must be greater than 0")
+	}
+
+	return nil
+}
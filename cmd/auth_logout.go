@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var authLogoutCfg *viper.Viper
+
+var authLogoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Signs out and removes the cached ticket",
+	Long:  ``,
+	Args:  authLogoutCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		file := globalCfg.TicketFile()
+
+		output, err := client.DeleteTicketWithContext(ctx, file)
+		if qb.IsTicketFileErr(err) {
+			cliutil.HandleError(err, "error removing ticket file")
+		}
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, AuthLogoutOutput{TicketFile: file})
+	},
+}
+
+func init() {
+	authCmd.AddCommand(authLogoutCmd)
+	authLogoutCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+}
+
+func authLogoutCmdValidate(cmd *cobra.Command, args []string) error {
+	return globalCfg.Validate()
+}
+
+// AuthLogoutOutput models the output printed after signing out.
+type AuthLogoutOutput struct {
+	TicketFile string `json:"ticket_file"`
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var authStatusCfg *viper.Viper
+
+var authStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Shows who is logged in and when the cached ticket expires",
+	Long:  ``,
+	Args:  authStatusCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		file := globalCfg.TicketFile()
+
+		info, err := qb.ReadCachedTicketInfo(file)
+		cliutil.HandleError(err, "error reading ticket file")
+
+		if info.Ticket == "" {
+			cliutil.HandleError(errors.New("no cached ticket"), "not logged in")
+		}
+
+		render(newAuthOutput(info, file))
+	},
+}
+
+func init() {
+	authCmd.AddCommand(authStatusCmd)
+	authStatusCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+}
+
+func authStatusCmdValidate(cmd *cobra.Command, args []string) error {
+	return globalCfg.InitConfig()
+}
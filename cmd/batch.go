@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+)
+
+// batchRecord models a record read from STDIN in batch mode. Its shape
+// matches the records rendered by the query command in batch mode so that
+// commands can be chained together, e.g. "query --batch | record edit --batch".
+type batchRecord struct {
+	ID     int                    `json:"record_id"`
+	Fields map[string]interface{} `json:"fields"`
+}
+
+// builtInFields contains the IDs and default labels of the fields every table
+// has, which are maintained by Quick Base and cannot be written. The labels
+// are included because "query --batch --use-labels" keys fields by label.
+var builtInFields = map[string]bool{
+	"1":                true,
+	"2":                true,
+	"3":                true,
+	"4":                true,
+	"5":                true,
+	"Date Created":     true,
+	"Date Modified":    true,
+	"Record ID#":       true,
+	"Record Owner":     true,
+	"Last Modified By": true,
+}
+
+// values returns the record's field values as strings keyed by field ID or
+// label. Values in overrides take precedence over the record's values. The
+// record's built-in fields are dropped, because records rendered by the query
+// command usually contain them, e.g. "query --fields=3 --batch".
+func (r batchRecord) values(overrides map[string]string) map[string]string {
+	m := make(map[string]string, len(r.Fields)+len(overrides))
+	for field, value := range r.Fields {
+		if builtInFields[field] {
+			continue
+		}
+		if value == nil {
+			m[field] = ""
+		} else {
+			m[field] = fmt.Sprint(value)
+		}
+	}
+	for field, value := range overrides {
+		m[field] = value
+	}
+	return m
+}
+
+// scanBatchRecords reads records from STDIN, one JSON object per line, and
+// invokes fn for each record.
+func scanBatchRecords(fn func(batchRecord) error) error {
+	return cliutil.ScanLines(os.Stdin, func(line []byte) error {
+		var r batchRecord
+
+		// Use json.Number so numeric values are passed through as-is.
+		d := json.NewDecoder(bytes.NewReader(line))
+		d.UseNumber()
+		if err := d.Decode(&r); err != nil {
+			return fmt.Errorf("error parsing record %q: %s", line, err)
+		}
+
+		return fn(r)
+	})
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+// withStdin replaces os.Stdin with a pipe that contains input for the
+// duration of fn.
+func withStdin(t *testing.T, input string, fn func()) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("error creating pipe: %s", err)
+	}
+	if _, err := w.WriteString(input); err != nil {
+		t.Fatalf("error writing to pipe: %s", err)
+	}
+	w.Close()
+
+	stdin := os.Stdin
+	os.Stdin = r
+	defer func() {
+		os.Stdin = stdin
+		r.Close()
+	}()
+
+	fn()
+}
+
+func TestBatchRecordValuesFromQuery(t *testing.T) {
+
+	// Records rendered by "query --fields=3 --batch" only contain Record ID#,
+	// which cannot be written, so only the values passed as arguments are set.
+	var got []map[string]string
+	withStdin(t, `{"record_id":12,"update_id":1,"fields":{"3":12}}`+"\n", func() {
+		err := scanBatchRecords(func(r batchRecord) error {
+			got = append(got, r.values(map[string]string{"8": "Done"}))
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
+	want := []map[string]string{{"8": "Done"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestBatchRecordValuesMerge(t *testing.T) {
+	r := batchRecord{Fields: map[string]interface{}{
+		"1": "1577836800000",
+		"6": "Task",
+		"7": "Open",
+		"8": nil,
+	}}
+
+	got := r.values(map[string]string{"7": "Done"})
+	want := map[string]string{"6": "Task", "7": "Done", "8": ""}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestBatchRecordValuesFromQueryWithLabels(t *testing.T) {
+
+	// Records rendered by "query --batch --use-labels" key the built-in fields
+	// by their labels, which are dropped as well.
+	r := batchRecord{Fields: map[string]interface{}{
+		"Date Created":     "1577836800000",
+		"Date Modified":    "1577836800000",
+		"Record ID#":       "12",
+		"Record Owner":     "112149.bhsv",
+		"Last Modified By": "112149.bhsv",
+		"Status":           "Open",
+	}}
+
+	got := r.values(nil)
+	want := map[string]string{"Status": "Open"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
@@ -6,7 +6,7 @@ import (
 
 var csvCmd = &cobra.Command{
 	Use:   "csv",
-	Short: "Commands import/export data in CSV format",
+	Short: "Commands that import/export data in CSV format",
 	Long:  ``,
 	Run: func(cmd *cobra.Command, args []string) {
 		cmd.Help()
@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var csvExportCfg *viper.Viper
+
+var csvExportCmd = &cobra.Command{
+	Use:   "export [FILEPATH]",
+	Short: "exports records matched by a query to a CSV file",
+	Long: `Exports all records matched by a query in CSV format. The first row contains
+the field IDs of the columns, or their labels if --use-labels is passed.
+Records are written to STDOUT unless FILEPATH is passed, in which case the file
+is only created or replaced once all records are exported.
+
+Data exported with field IDs in the header row can be imported back into the
+table, e.g. "csv import --fields=3,7,8 --merge-field-id=3 --skip-first-row".`,
+	Args: csvExportCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := newDoQueryInput(csvExportCfg)
+		input.Limit(csvExportCfg.GetInt("page-size"))
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		useLabels := csvExportCfg.GetBool("use-labels")
+
+		if len(args) == 0 {
+			_, err := exportCSV(ctx, client, input, os.Stdout, useLabels)
+			cliutil.HandleError(err, "error exporting records")
+			return
+		}
+
+		// Report what was exported when the CSV isn't the command's output.
+		n, err := exportCSVFile(ctx, client, input, args[0], useLabels)
+		cliutil.HandleError(err, "error exporting records")
+		render(CSVExportOutput{File: args[0], NumRecords: n})
+	},
+}
+
+func init() {
+	csvCmd.AddCommand(csvExportCmd)
+	csvExportCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(csvExportCmd, csvExportCfg)
+	addDoQueryFlags(flags)
+	flags.Int("page-size", "p", qb.DefaultPageSize, "number of records requested per page")
+	flags.Bool("use-labels", "u", false, "use field labels instead of field IDs in the header row")
+}
+
+func csvExportCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireTableID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if csvExportCfg.GetInt("page-size") <= 0 {
+		return errors.New("page-size option invalid: must be greater than 0")
+	}
+
+	return nil
+}
+
+// exportCSV writes the records matched by the query to w in CSV format,
+// writing each page of records as it is returned. The number of records
+// written is returned.
+func exportCSV(ctx context.Context, client qb.Client, input *qb.DoQueryInput, w io.Writer, useLabels bool) (n int, err error) {
+	cw := csv.NewWriter(w)
+	cw.UseCRLF = true
+
+	var columns []int
+	var werr error
+	wroteHeader := false
+
+	err = client.DoQueryPagesWithContext(ctx, input, func(output qb.DoQueryOutput, lastPage bool) bool {
+
+		// Write the header row using the fields returned with the first page.
+		// Columns are in the order of the fields option if it was passed.
+		if !wroteHeader {
+			wroteHeader = true
+			labels := make(map[int]string, len(output.Fields))
+			for _, f := range output.Fields {
+				columns = append(columns, f.FieldID)
+				labels[f.FieldID] = f.Label
+			}
+			if len(input.FieldList) > 0 {
+				columns = input.FieldList
+			}
+
+			header := make([]string, len(columns))
+			for k, fid := range columns {
+				if useLabels {
+					header[k] = labels[fid]
+				} else {
+					header[k] = strconv.Itoa(fid)
+				}
+			}
+			if werr = cw.Write(header); werr != nil {
+				return false
+			}
+		}
+
+		for _, r := range output.Records {
+			values := make(map[int]string, len(r.Fields))
+			for _, f := range r.Fields {
+				values[f.FieldID] = f.Value
+			}
+
+			row := make([]string, len(columns))
+			for k, fid := range columns {
+				row[k] = values[fid]
+			}
+			if werr = cw.Write(row); werr != nil {
+				return false
+			}
+			n++
+		}
+
+		cw.Flush()
+		werr = cw.Error()
+		return werr == nil
+	})
+
+	if err == nil {
+		err = werr
+	}
+	return
+}
+
+// exportCSVFile exports the records matched by the query to a temporary file
+// in the same directory as path, and renames it to path once all records are
+// written so that a failed export doesn't leave a truncated file behind. The
+// temporary file is only readable by its owner, so it is made readable by
+// everyone before it is renamed like a file created by os.Create would be.
+func exportCSVFile(ctx context.Context, client qb.Client, input *qb.DoQueryInput, path string, useLabels bool) (n int, err error) {
+	f, err := ioutil.TempFile(filepath.Dir(path), ".export-")
+	if err != nil {
+		return
+	}
+
+	n, err = exportCSV(ctx, client, input, f, useLabels)
+	if err == nil {
+		err = f.Chmod(0644)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return
+	}
+
+	if err = os.Rename(f.Name(), path); err != nil {
+		os.Remove(f.Name())
+	}
+	return
+}
+
+// CSVExportOutput models the output printed after records are exported to
+// a file.
+type CSVExportOutput struct {
+	File       string `json:"file"`
+	NumRecords int    `json:"num_records"`
+}
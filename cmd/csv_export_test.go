@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/cpliakas/quickbase-do-query/qbutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newTestClient returns a qb.Client that sends requests to a test server
+// that handles them with fn.
+func newTestClient(fn http.HandlerFunc) (*httptest.Server, qb.Client) {
+	server := httptest.NewServer(fn)
+
+	cfg := qbutil.NewGlobalConfig(&cobra.Command{}, viper.New())
+	cfg.Set("realm-host", server.URL)
+
+	client := qb.NewClient(cfg)
+	client.HTTPClient = server.Client()
+	return server, client
+}
+
+func TestExportCSVFile(t *testing.T) {
+	server, client := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<qdbapi><action>API_DoQuery</action><errcode>0</errcode><table>
+			<fields><field id="3"><label>Record ID#</label></field><field id="7"><label>Name</label></field></fields>
+			<records><record><f id="3">1</f><f id="7">Task</f></record></records>
+		</table></qdbapi>`))
+	})
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "csv-export-")
+	if err != nil {
+		t.Fatalf("error creating directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "records.csv")
+
+	n, err := exportCSVFile(context.Background(), client, &qb.DoQueryInput{TableID: "bpdhfphi2"}, path, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n != 1 {
+		t.Errorf("expected 1 record, got %v", n)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("error reading file: %s", err)
+	}
+	if expected := "3,7\r\n1,Task\r\n"; string(b) != expected {
+		t.Errorf("expected %q, got %q", expected, string(b))
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("error reading file info: %s", err)
+	}
+	if mode := info.Mode().Perm(); mode != 0644 {
+		t.Errorf("expected mode 0644, got %o", mode)
+	}
+}
+
+func TestExportCSVFileError(t *testing.T) {
+	server, client := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<qdbapi><action>API_DoQuery</action><errcode>4</errcode><errtext>User not authorized</errtext></qdbapi>`))
+	})
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "csv-export-")
+	if err != nil {
+		t.Fatalf("error creating directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "records.csv")
+	if err := ioutil.WriteFile(path, []byte("previous export"), 0644); err != nil {
+		t.Fatalf("error writing file: %s", err)
+	}
+
+	if _, err := exportCSVFile(context.Background(), client, &qb.DoQueryInput{TableID: "bpdhfphi2"}, path, false); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	// The previous export is left untouched, and no temporary file remains.
+	if b, _ := ioutil.ReadFile(path); string(b) != "previous export" {
+		t.Errorf("expected the existing file to be kept, got %q", string(b))
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Errorf("expected only the existing file in the directory, got %v files", len(files))
+	}
+}
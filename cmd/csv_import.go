@@ -38,12 +38,14 @@ var csvImportCmd = &cobra.Command{
 		cliutil.HandleError(err, "error reading file")
 		input.CSV(fileData)
 
-		client := qb.NewClient(globalCfg)
-		output, err := client.ImportFromCSV(input)
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.ImportFromCSVWithContext(ctx, input)
 		cliutil.HandleError(err, "error formatting output")
 
 		// TODO Nice output
-		cliutil.PrintJSON(output)
+		render(output)
 	},
 }
 
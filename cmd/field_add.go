@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var fieldAddCfg *viper.Viper
+
+var fieldAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Adds a field",
+	Long:  ``,
+	Args:  fieldAddCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.AddFieldInput{
+			TableID: globalCfg.TableID(),
+			Label:   fieldAddCfg.GetString("label"),
+			Type:    fieldAddCfg.GetString("type"),
+			Mode:    fieldAddCfg.GetString("mode"),
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.AddFieldWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		render(output)
+	},
+}
+
+func init() {
+	fieldCmd.AddCommand(fieldAddCmd)
+	fieldAddCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(fieldAddCmd, fieldAddCfg)
+	flags.String("label", "l", "", "the field's label")
+	flags.String("mode", "m", "", "the field's mode, one of "+strings.Join(fieldModes, ", ")+", defaults to a regular field")
+	flags.String("type", "y", "", "the field's type, one of "+strings.Join(qb.FieldTypes(), ", "))
+}
+
+func fieldAddCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireTableID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if fieldAddCfg.GetString("label") == "" {
+		return errors.New("missing required option: label")
+	}
+
+	t := fieldAddCfg.GetString("type")
+	if t == "" {
+		return errors.New("missing required option: type")
+	}
+	if !contains(qb.FieldTypes(), t) {
+		return fmt.Errorf("type option invalid: must be one of %s", strings.Join(qb.FieldTypes(), ", "))
+	}
+
+	m := fieldAddCfg.GetString("mode")
+	if m != "" && !contains(fieldModes, m) {
+		return fmt.Errorf("mode option invalid: must be one of %s", strings.Join(fieldModes, ", "))
+	}
+
+	return nil
+}
+
+// fieldModes contains the modes that can be passed to "field add".
+var fieldModes = []string{qb.FieldModeVirtual, qb.FieldModeLookup}
+
+// contains returns whether the slice contains s.
+func contains(slice []string, s string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
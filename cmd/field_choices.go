@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var fieldChoicesCmd = &cobra.Command{
+	Use:   "choices",
+	Short: "Commands that act on a multiple-choice field's choices",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+func init() {
+	fieldCmd.AddCommand(fieldChoicesCmd)
+}
+
+// fieldChoicesCmdValidate validates the arguments and options common to the
+// "field choices" commands.
+func fieldChoicesCmdValidate(cfg *viper.Viper, args []string) error {
+	globalCfg.RequireTableID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if len(args) < 1 {
+		return errors.New("missing required argument: [CHOICES]")
+	}
+	if cfg.GetInt("field-id") <= 0 {
+		return errors.New("missing required option: field-id")
+	}
+
+	return nil
+}
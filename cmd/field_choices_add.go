@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var fieldChoicesAddCfg *viper.Viper
+
+var fieldChoicesAddCmd = &cobra.Command{
+	Use:   "add [CHOICES]",
+	Short: "Adds choices to a multiple-choice field",
+	Long: `Adds choices to a multiple-choice field. Each argument is a choice, so
+quote choices that contain spaces, e.g. "In progress".`,
+	Args: fieldChoicesAddCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.FieldAddChoicesInput{
+			TableID: globalCfg.TableID(),
+			FieldID: fieldChoicesAddCfg.GetInt("field-id"),
+			Choices: args,
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.FieldAddChoicesWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		render(output)
+	},
+}
+
+func init() {
+	fieldChoicesCmd.AddCommand(fieldChoicesAddCmd)
+	fieldChoicesAddCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(fieldChoicesAddCmd, fieldChoicesAddCfg)
+	flags.Int("field-id", "f", 0, "ID of the field the choices are added to")
+}
+
+func fieldChoicesAddCmdValidate(cmd *cobra.Command, args []string) error {
+	return fieldChoicesCmdValidate(fieldChoicesAddCfg, args)
+}
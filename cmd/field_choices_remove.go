@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var fieldChoicesRemoveCfg *viper.Viper
+
+var fieldChoicesRemoveCmd = &cobra.Command{
+	Use:   "remove [CHOICES]",
+	Short: "Removes choices from a multiple-choice field",
+	Long: `Removes choices from a multiple-choice field. Each argument is a choice, so
+quote choices that contain spaces, e.g. "In progress".`,
+	Args: fieldChoicesRemoveCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.FieldRemoveChoicesInput{
+			TableID: globalCfg.TableID(),
+			FieldID: fieldChoicesRemoveCfg.GetInt("field-id"),
+			Choices: args,
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.FieldRemoveChoicesWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		render(output)
+	},
+}
+
+func init() {
+	fieldChoicesCmd.AddCommand(fieldChoicesRemoveCmd)
+	fieldChoicesRemoveCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(fieldChoicesRemoveCmd, fieldChoicesRemoveCfg)
+	flags.Int("field-id", "f", 0, "ID of the field the choices are removed from")
+}
+
+func fieldChoicesRemoveCmdValidate(cmd *cobra.Command, args []string) error {
+	return fieldChoicesCmdValidate(fieldChoicesRemoveCfg, args)
+}
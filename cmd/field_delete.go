@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var fieldDeleteCfg *viper.Viper
+
+var fieldDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Deletes a field",
+	Long: `Deletes a field and the data stored in it, which cannot be recovered. The
+field is only deleted after confirming at the prompt or if --yes is passed.`,
+	Args: fieldDeleteCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.DeleteFieldInput{
+			TableID: globalCfg.TableID(),
+			FieldID: fieldDeleteCfg.GetInt("field-id"),
+		}
+
+		if !fieldDeleteCfg.GetBool("yes") {
+			prompt := fmt.Sprintf("Permanently delete field %v and its data?", input.FieldID)
+			ok, err := cliutil.Confirm(prompt)
+			cliutil.HandleError(err, "error confirming deletion, pass --yes to skip confirmation")
+			if !ok {
+				cliutil.HandleError(errors.New("deletion canceled"), "")
+			}
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.DeleteFieldWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, FieldDeleteOutput{
+			UserData: output.UserData,
+			FieldID:  input.FieldID,
+		})
+	},
+}
+
+func init() {
+	fieldCmd.AddCommand(fieldDeleteCmd)
+	fieldDeleteCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(fieldDeleteCmd, fieldDeleteCfg)
+	flags.Int("field-id", "f", 0, "ID of the field being deleted")
+	flags.Bool("yes", "y", false, "delete the field without prompting for confirmation")
+}
+
+func fieldDeleteCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireTableID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if fieldDeleteCfg.GetInt("field-id") <= 0 {
+		return errors.New("missing required option: field-id")
+	}
+
+	return nil
+}
+
+// FieldDeleteOutput models the output printed after a field is deleted.
+type FieldDeleteOutput struct {
+	UserData string `json:"user_data,omitempty"`
+	FieldID  int    `json:"field_id"`
+}
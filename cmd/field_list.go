@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/cpliakas/quickbase-do-query/cliutil"
 	"github.com/cpliakas/quickbase-do-query/qb"
 	"github.com/spf13/cobra"
@@ -12,15 +15,25 @@ var fieldListCfg *viper.Viper
 var fieldListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Lists fields",
-	Long:  ``,
-	Args:  fieldListCmdValidate,
+	Long: `Lists a table's fields as a map of field ID to label.
+
+Pass --detailed to list each field's properties, e.g. whether it is required or
+unique, its choices, default value, formula, and the relationship it is part of.`,
+	Args: fieldListCmdValidate,
 	Run: func(cmd *cobra.Command, args []string) {
 		input := &qb.GetSchemaInput{ID: globalCfg.TableID()}
 
-		client := qb.NewClient(globalCfg)
-		output, err := client.GetSchema(input)
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.GetSchemaWithContext(ctx, input)
 		cliutil.HandleError(err, "error executing request")
 
+		if fieldListCfg.GetBool("detailed") {
+			renderResponse(output, FieldListDetailedOutput{Fields: output.Fields})
+			return
+		}
+
 		// Build map of field ID to labels.
 		fields := make(map[int]string)
 		for _, f := range output.Fields {
@@ -28,13 +41,16 @@ var fieldListCmd = &cobra.Command{
 		}
 
 		v := FieldListOutput{Fields: fields}
-		cliutil.PrintJSON(v)
+		renderResponse(output, v)
 	},
 }
 
 func init() {
 	fieldCmd.AddCommand(fieldListCmd)
 	fieldListCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(fieldListCmd, fieldListCfg)
+	flags.Bool("detailed", "d", false, "list each field's properties")
 }
 
 func fieldListCmdValidate(cmd *cobra.Command, args []string) error {
@@ -46,3 +62,28 @@ func fieldListCmdValidate(cmd *cobra.Command, args []string) error {
 type FieldListOutput struct {
 	Fields map[int]string `json:"fields"`
 }
+
+// FieldListDetailedOutput models the output that lists fields and their
+// properties.
+type FieldListDetailedOutput struct {
+	Fields []qb.GetSchemaOutputField `json:"fields"`
+}
+
+// Table implements cliutil.Tabular and renders a row per field.
+func (out FieldListDetailedOutput) Table() (header []string, rows [][]string) {
+	header = []string{"ID", "Label", "Type", "Mode", "Required", "Unique", "Default", "Choices"}
+	rows = make([][]string, len(out.Fields))
+	for k, f := range out.Fields {
+		rows[k] = []string{
+			strconv.Itoa(f.FieldID),
+			f.Label,
+			f.Type,
+			f.Mode,
+			strconv.FormatBool(f.Required),
+			strconv.FormatBool(f.Unique),
+			f.DefaultValue,
+			strings.Join(f.Choices, ", "),
+		}
+	}
+	return
+}
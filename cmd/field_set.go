@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var fieldSetCfg *viper.Viper
+
+var fieldSetCmd = &cobra.Command{
+	Use:   "set [PROPERTIES]",
+	Short: "Sets a field's properties",
+	Long: `Sets a field's properties, which are passed as key value pairs, e.g.
+label="Due Date" required=1 unique=0.
+
+See https://help.quickbase.com/api-guide/setfieldproperties.html for the
+properties that can be set.`,
+	Args: fieldSetCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		properties := cliutil.ParseKeyValue(strings.Join(args, " "))
+		input := &qb.SetFieldPropertiesInput{
+			TableID:    globalCfg.TableID(),
+			FieldID:    fieldSetCfg.GetInt("field-id"),
+			Properties: properties,
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.SetFieldPropertiesWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, FieldSetOutput{
+			UserData:   output.UserData,
+			FieldID:    input.FieldID,
+			Properties: properties,
+		})
+	},
+}
+
+func init() {
+	fieldCmd.AddCommand(fieldSetCmd)
+	fieldSetCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(fieldSetCmd, fieldSetCfg)
+	flags.Int("field-id", "f", 0, "ID of the field being modified")
+}
+
+func fieldSetCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireTableID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if len(args) < 1 {
+		return errors.New("missing required argument: [PROPERTIES]")
+	}
+	if fieldSetCfg.GetInt("field-id") <= 0 {
+		return errors.New("missing required option: field-id")
+	}
+
+	return nil
+}
+
+// FieldSetOutput models the output printed after a field's properties are
+// set.
+type FieldSetOutput struct {
+	UserData   string            `json:"user_data,omitempty"`
+	FieldID    int               `json:"field_id"`
+	Properties map[string]string `json:"properties"`
+}
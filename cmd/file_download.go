@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var fileDownloadCfg *viper.Viper
+
+var fileDownloadCmd = &cobra.Command{
+	Use:   "download",
+	Short: "Downloads a file",
+	Long: `Downloads the file attached to a record's file attachment field. The file
+is saved in the current directory using its name in Quick Base unless
+--output-file is passed, and "--output-file=-" writes the file to STDOUT.
+
+If --dir is passed, the files attached to every record matched by --query,
+--query-id, or --query-name are downloaded to a subdirectory of --dir named
+after the record's ID. Records without a file are skipped.`,
+	Args: fileDownloadCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		fid := fileDownloadCfg.GetInt("field-id")
+		if dir := fileDownloadCfg.GetString("dir"); dir != "" {
+			downloadAll(ctx, client, fid, dir)
+			return
+		}
+
+		input := &qb.DownloadFileInput{
+			TableID:  globalCfg.TableID(),
+			RecordID: fileDownloadCfg.GetInt("record-id"),
+			FieldID:  fid,
+			Version:  fileDownloadCfg.GetInt("version"),
+		}
+
+		path := fileDownloadCfg.GetString("output-file")
+		if path == "-" {
+			_, err := client.DownloadFileWithContext(ctx, input, os.Stdout)
+			cliutil.HandleError(err, "error downloading file")
+			return
+		}
+
+		dir := "."
+		if path != "" {
+			dir = filepath.Dir(path)
+		}
+
+		output, err := downloadFile(ctx, client, input, dir, path)
+		cliutil.HandleError(err, "error downloading file")
+		render(output)
+	},
+}
+
+func init() {
+	fileCmd.AddCommand(fileDownloadCmd)
+	fileDownloadCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(fileDownloadCmd, fileDownloadCfg)
+	flags.String("dir", "d", "", "directory the files attached to the records matched by the query are downloaded to")
+	flags.Int("field-id", "f", 0, "the file's field ID")
+	flags.String("output-file", "o", "", "path the file is saved to, - for STDOUT")
+	addQueryFlags(flags)
+	flags.Int("record-id", "r", 0, "record ID the file is downloaded from")
+	flags.Int("version", "v", 0, "version of the file, defaults to the latest version")
+}
+
+func fileDownloadCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireTableID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if fileDownloadCfg.GetInt("field-id") <= 0 {
+		return errors.New("missing required option: field-id")
+	}
+	if fileDownloadCfg.GetInt("version") < 0 {
+		return errors.New("version option invalid: must not be negative")
+	}
+
+	if fileDownloadCfg.GetString("dir") != "" {
+		if fileDownloadCfg.GetInt("record-id") > 0 {
+			return errors.New("record-id option cannot be used with the dir option")
+		}
+		if fileDownloadCfg.GetString("output-file") != "" {
+			return errors.New("output-file option cannot be used with the dir option")
+		}
+	} else if fileDownloadCfg.GetInt("record-id") <= 0 {
+		return errors.New("missing required option: record-id")
+	}
+
+	return nil
+}
+
+// downloadAll downloads the file attached to the field of every record
+// matched by the query to a subdirectory of dir named after the record's ID.
+func downloadAll(ctx context.Context, client qb.Client, fid int, dir string) {
+	input := &qb.DoQueryInput{TableID: globalCfg.TableID()}
+	input.Query, input.QueryID, input.QueryName = parseQueryFlags(fileDownloadCfg)
+	input.FieldList = qb.FieldList{fid}
+
+	files := []FileDownloadOutput{}
+	err := client.DoQueryPagesWithContext(ctx, input, func(output qb.DoQueryOutput, lastPage bool) bool {
+		for _, r := range output.Records {
+			if !hasFile(r, fid) {
+				continue
+			}
+
+			rdir := filepath.Join(dir, strconv.Itoa(r.RecordID))
+			err := os.MkdirAll(rdir, 0755)
+			cliutil.HandleError(err, "error creating directory")
+
+			file, err := downloadFile(ctx, client, &qb.DownloadFileInput{
+				TableID:  input.TableID,
+				RecordID: r.RecordID,
+				FieldID:  fid,
+				Version:  fileDownloadCfg.GetInt("version"),
+			}, rdir, "")
+			cliutil.HandleError(err, "error downloading file")
+			files = append(files, file)
+		}
+		return true
+	})
+	cliutil.HandleError(err, "error executing request")
+
+	render(FileDownloadListOutput{Files: files})
+}
+
+// hasFile returns whether a file is attached to the record's field. The value
+// of a file attachment field is the file's name.
+func hasFile(r qb.DoQueryOutputRecord, fid int) bool {
+	for _, f := range r.Fields {
+		if f.FieldID == fid {
+			return f.Value != ""
+		}
+	}
+	return false
+}
+
+// downloadFile downloads the file to path. If path is empty, the file is saved
+// in dir using its name in Quick Base. The file is written to a temporary file
+// in dir first so that failed downloads don't leave partial files behind.
+func downloadFile(ctx context.Context, client qb.Client, input *qb.DownloadFileInput, dir, path string) (output FileDownloadOutput, err error) {
+	f, err := ioutil.TempFile(dir, ".download-")
+	if err != nil {
+		return
+	}
+
+	file, err := client.DownloadFileWithContext(ctx, input, f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return
+	}
+
+	if path == "" {
+		name := filepath.Base(file.FileName)
+		if file.FileName == "" || name == "." || name == string(filepath.Separator) {
+			name = fmt.Sprintf("r%d-f%d", input.RecordID, input.FieldID)
+		}
+		path = filepath.Join(dir, name)
+	}
+
+	if err = os.Rename(f.Name(), path); err != nil {
+		os.Remove(f.Name())
+		return
+	}
+
+	output = FileDownloadOutput{
+		RecordID: input.RecordID,
+		FieldID:  input.FieldID,
+		Path:     path,
+		Size:     file.Size,
+	}
+	return
+}
+
+// FileDownloadOutput models the output printed after a file is downloaded.
+type FileDownloadOutput struct {
+	RecordID int    `json:"record_id"`
+	FieldID  int    `json:"field_id"`
+	Path     string `json:"path"`
+	Size     int64  `json:"size"`
+}
+
+// FileDownloadListOutput models the output printed after the files attached to
+// the records matched by a query are downloaded.
+type FileDownloadListOutput struct {
+	Files []FileDownloadOutput `json:"files"`
+}
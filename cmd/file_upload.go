@@ -1,10 +1,16 @@
 package cmd
 
 import (
-	"encoding/base64"
+	"context"
+	"encoding/csv"
 	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/cpliakas/quickbase-do-query/cliutil"
@@ -13,41 +19,96 @@ import (
 	"github.com/spf13/viper"
 )
 
+// defaultUploadPattern is the default pattern that maps the files in the
+// directory passed via --dir to records, e.g. "12-report.pdf" is uploaded to
+// record 12.
+const defaultUploadPattern = `^(?P<rid>\d+)(?:\D|$)`
+
 var fileUploadCfg *viper.Viper
 
 var fileUploadCmd = &cobra.Command{
-	Use:   "upload [FILEPATH]",
-	Short: "Uploads a file ",
-	Long:  ``,
-	Args:  fileUploadCmdValidate,
+	Use:   "upload [FILEPATH | FIELD_ID=FILEPATH...]",
+	Short: "Uploads files",
+	Long: `Uploads files to the record specified by --record-id. Pass FIELD_ID=FILEPATH
+pairs to upload multiple files in one request, or FILEPATH to upload a file to
+the field specified by --field-id.
+
+In batch mode, files are uploaded to the record identified by each line read
+from STDIN. Each line is a JSON object in the format rendered by
+"query --batch", where the field values are the paths of the files to upload,
+e.g. {"record_id":1,"fields":{"9":"file:///path/to/file.pdf"}}. If files are
+passed as arguments, they are uploaded to every record instead.
+
+Pass --dir to upload the files in a directory, which are mapped to records by
+matching their names against --pattern. The pattern is a regular expression
+with a "rid" group that captures the record ID and an optional "fid" group that
+captures the field ID, which defaults to --field-id. Pass --manifest to map
+files to records with a CSV file instead, which has "record_id" and "path"
+columns and optional "field_id" and "file_name" columns. Relative paths are
+relative to --dir, or to the manifest's directory if --dir isn't passed.
+
+The files for each record are uploaded in one request and are streamed instead
+of being read into memory. In batch mode and with --dir or --manifest, the
+result is reported per file, and the command exits with a non-zero status if
+any file fails to upload, so --raw can only be used when uploading to the
+record specified by --record-id.`,
+	Args: fileUploadCmdValidate,
 	Run: func(cmd *cobra.Command, args []string) {
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
 
-		filePath := strings.TrimPrefix(args[0], "file://")
-		fileData, err := ioutil.ReadFile(filePath)
-		cliutil.HandleError(err, "error reading file")
+		dir := fileUploadCfg.GetString("dir")
+		manifest := fileUploadCfg.GetString("manifest")
 
-		fileName := fileUploadCfg.GetString("file-name")
-		if fileName == "" {
-			fileName = filepath.Base(filePath)
-		}
+		var results []FileUploadOutputFile
+		switch {
+		case manifest != "":
+			files, err := readUploadManifest(manifest, dir)
+			cliutil.HandleError(err, "error reading manifest")
+			results = uploadFiles(ctx, client, files)
 
-		field := qb.UploadFileInputField{
-			ID:       fileUploadCfg.GetInt("field-id"),
-			FileData: base64.StdEncoding.EncodeToString(fileData),
-			Name:     fileName,
-		}
+		case dir != "":
+			files, err := matchUploadFiles(dir, fileUploadCfg.GetString("pattern"))
+			cliutil.HandleError(err, "error reading directory")
+			results = uploadFiles(ctx, client, files)
 
-		input := &qb.UploadFileInput{
-			TableID:  globalCfg.TableID(),
-			RecordID: fileUploadCfg.GetInt("record-id"),
-			Fields:   []qb.UploadFileInputField{field},
-		}
+		case !globalCfg.Batch():
+			files, err := parseUploadArgs(fileUploadCfg.GetInt("record-id"), args)
+			cliutil.HandleError(err, "error parsing arguments")
+			uploadRecord(ctx, client, fileUploadCfg.GetInt("record-id"), files)
+			return
 
-		client := qb.NewClient(globalCfg)
-		output, err := client.UploadFile(input)
-		cliutil.HandleError(err, "error formatting output")
+		default:
+			err := scanBatchRecords(func(r batchRecord) error {
+				if r.ID <= 0 {
+					return errors.New("record_id missing from record")
+				}
 
-		cliutil.PrintJSON(output)
+				if len(args) > 0 {
+					files, err := parseUploadArgs(r.ID, args)
+					if err != nil {
+						return err
+					}
+					results = append(results, uploadFiles(ctx, client, files)...)
+					return nil
+				}
+
+				files := []uploadFile{}
+				for fidStr, filePath := range r.values(nil) {
+					fid, err := strconv.Atoi(fidStr)
+					if err != nil {
+						return fmt.Errorf("invalid field ID: %s", fidStr)
+					}
+					files = append(files, newUploadFile(r.ID, fid, filePath, ""))
+				}
+				results = append(results, uploadFiles(ctx, client, files)...)
+				return nil
+			})
+			cliutil.HandleError(err, "error reading records")
+		}
+
+		renderUploadResults(results)
 	},
 }
 
@@ -56,8 +117,11 @@ func init() {
 	fileUploadCfg = cliutil.InitConfig(qb.EnvVarPrefix)
 
 	flags := cliutil.NewFlagger(fileUploadCmd, fileUploadCfg)
+	flags.String("dir", "d", "", "directory containing the files to upload")
 	flags.Int("field-id", "f", 0, "the file 's field ID")
 	flags.String("file-name", "n", "", "the name of file stored in the record")
+	flags.String("manifest", "m", "", "CSV file mapping files to records")
+	flags.String("pattern", "p", defaultUploadPattern, "regular expression mapping the names of the files in --dir to records")
 	flags.Int("record-id", "r", 0, "record ID the file is being uploaded to")
 }
 
@@ -67,16 +131,359 @@ func fileUploadCmdValidate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if len(args) < 1 {
+	// Bulk uploads report the result of each file instead of rendering the
+	// API responses, so there is no raw output to return.
+	bulk := fileUploadCfg.GetString("dir") != "" || fileUploadCfg.GetString("manifest") != ""
+	if globalCfg.Raw() && (bulk || globalCfg.Batch()) {
+		return errors.New("raw option cannot be used in batch mode or with the dir or manifest options")
+	}
+
+	if bulk {
+		return validateUploadDir(args)
+	}
+
+	if len(args) < 1 && !globalCfg.Batch() {
 		return errors.New("missing required argument: [FILEPATH]")
 	}
+	if fileUploadCfg.GetInt("record-id") <= 0 && !globalCfg.Batch() {
+		return errors.New("missing required option: record-id")
+	}
+
+	_, err := parseUploadArgs(0, args)
+	return err
+}
+
+// validateUploadDir validates the options used when uploading the files in a
+// directory or manifest.
+func validateUploadDir(args []string) error {
+	if len(args) > 0 {
+		return errors.New("arguments cannot be used with the dir or manifest options")
+	}
+	if globalCfg.Batch() {
+		return errors.New("batch option cannot be used with the dir or manifest options")
+	}
+	if fileUploadCfg.GetInt("record-id") > 0 {
+		return errors.New("record-id option cannot be used with the dir or manifest options")
+	}
+
+	// The field ID might be read from the manifest, so it is validated when
+	// the manifest is read.
+	if fileUploadCfg.GetString("manifest") != "" {
+		return nil
+	}
 
-	if fileUploadCfg.GetInt("field-id") <= 0 {
+	re, err := regexp.Compile(fileUploadCfg.GetString("pattern"))
+	if err != nil {
+		return fmt.Errorf("pattern option invalid: %s", err)
+	}
+	if re.SubexpIndex("rid") < 0 {
+		return errors.New("pattern option invalid: missing rid group")
+	}
+	if re.SubexpIndex("fid") < 0 && fileUploadCfg.GetInt("field-id") <= 0 {
 		return errors.New("missing required option: field-id")
 	}
-	if fileUploadCfg.GetInt("record-id") <= 0 {
-		return errors.New("missing required option: record-id")
+
+	return nil
+}
+
+// uploadFile is a file that is uploaded to a record's field.
+type uploadFile struct {
+	recordID int
+	fieldID  int
+	path     string
+	name     string
+}
+
+// newUploadFile returns an uploadFile. The base name of the file is used if
+// name is empty.
+func newUploadFile(rid, fid int, path, name string) uploadFile {
+	path = strings.TrimPrefix(path, "file://")
+	if name == "" {
+		name = filepath.Base(path)
 	}
+	return uploadFile{recordID: rid, fieldID: fid, path: path, name: name}
+}
+
+// inputField returns the qb.UploadFileInputField that streams the file.
+func (f uploadFile) inputField() qb.UploadFileInputField {
+	path := f.path
+	return qb.UploadFileInputField{
+		ID:   f.fieldID,
+		Name: f.name,
+		Open: func() (io.ReadCloser, error) { return os.Open(path) },
+	}
+}
 
+// uploadArgPattern matches FIELD_ID=FILEPATH arguments.
+var uploadArgPattern = regexp.MustCompile(`^(\d+)=(.+)$`)
+
+// parseUploadArgs returns the files passed as arguments, which are either
+// FIELD_ID=FILEPATH pairs or a FILEPATH uploaded to the field passed via
+// --field-id.
+func parseUploadArgs(rid int, args []string) ([]uploadFile, error) {
+	files := make([]uploadFile, len(args))
+	for k, arg := range args {
+		if m := uploadArgPattern.FindStringSubmatch(arg); m != nil {
+			fid, _ := strconv.Atoi(m[1])
+			files[k] = newUploadFile(rid, fid, m[2], "")
+			continue
+		}
+
+		fid := fileUploadCfg.GetInt("field-id")
+		if fid <= 0 {
+			return nil, errors.New("missing required option: field-id")
+		}
+		files[k] = newUploadFile(rid, fid, arg, fileUploadCfg.GetString("file-name"))
+	}
+	return files, nil
+}
+
+// matchUploadFiles returns the files in dir whose names match pattern, which
+// captures the record ID and optionally the field ID. Subdirectories and
+// hidden files are skipped.
+func matchUploadFiles(dir, pattern string) ([]uploadFile, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []uploadFile{}
+	for _, info := range infos {
+		if info.IsDir() || strings.HasPrefix(info.Name(), ".") {
+			continue
+		}
+
+		m := re.FindStringSubmatch(info.Name())
+		if m == nil {
+			continue
+		}
+
+		rid, err := strconv.Atoi(m[re.SubexpIndex("rid")])
+		if err != nil {
+			return nil, fmt.Errorf("invalid record ID in file name %s", info.Name())
+		}
+
+		fid := fileUploadCfg.GetInt("field-id")
+		if i := re.SubexpIndex("fid"); i >= 0 && m[i] != "" {
+			if fid, err = strconv.Atoi(m[i]); err != nil {
+				return nil, fmt.Errorf("invalid field ID in file name %s", info.Name())
+			}
+		}
+
+		files = append(files, newUploadFile(rid, fid, filepath.Join(dir, info.Name()), ""))
+	}
+
+	return files, nil
+}
+
+// readUploadManifest returns the files listed in the manifest, a CSV file with
+// a header row. Relative paths are relative to dir, or the manifest's directory
+// if dir is empty.
+func readUploadManifest(manifest, dir string) ([]uploadFile, error) {
+	f, err := os.Open(manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if dir == "" {
+		dir = filepath.Dir(manifest)
+	}
+
+	r := csv.NewReader(f)
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("error reading header: %s", err)
+	}
+
+	cols := map[string]int{"record_id": -1, "field_id": -1, "path": -1, "file_name": -1}
+	for k, name := range header {
+		if _, ok := cols[name]; ok {
+			cols[name] = k
+		}
+	}
+	for _, name := range []string{"record_id", "path"} {
+		if cols[name] < 0 {
+			return nil, fmt.Errorf("missing required column: %s", name)
+		}
+	}
+
+	get := func(row []string, name string) string {
+		if cols[name] < 0 {
+			return ""
+		}
+		return row[cols[name]]
+	}
+
+	files := []uploadFile{}
+	for line := 2; ; line++ {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		rid, err := strconv.Atoi(get(row, "record_id"))
+		if err != nil || rid <= 0 {
+			return nil, fmt.Errorf("line %d: invalid record_id", line)
+		}
+
+		fid := fileUploadCfg.GetInt("field-id")
+		if s := get(row, "field_id"); s != "" {
+			if fid, err = strconv.Atoi(s); err != nil {
+				return nil, fmt.Errorf("line %d: invalid field_id", line)
+			}
+		}
+		if fid <= 0 {
+			return nil, fmt.Errorf("line %d: missing field_id, pass the field-id option or add a field_id column", line)
+		}
+
+		path := get(row, "path")
+		if path == "" {
+			return nil, fmt.Errorf("line %d: missing path", line)
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		files = append(files, newUploadFile(rid, fid, path, get(row, "file_name")))
+	}
+
+	return files, nil
+}
+
+// uploadRecord uploads the files passed as arguments to the record in one
+// request and renders the API response.
+func uploadRecord(ctx context.Context, client qb.Client, rid int, files []uploadFile) {
+	input := &qb.UploadFileInput{
+		TableID:  globalCfg.TableID(),
+		RecordID: rid,
+	}
+	for _, f := range files {
+		cliutil.HandleError(checkUploadFile(f.path), "error reading file")
+		input.Fields = append(input.Fields, f.inputField())
+	}
+
+	output, err := client.UploadFileWithContext(ctx, input)
+	cliutil.HandleError(err, "error executing request")
+
+	renderResponse(output, output)
+}
+
+// uploadFiles uploads the files, making one request per record, and returns
+// the result of each file. Files that can't be read are reported as failed
+// without being sent.
+func uploadFiles(ctx context.Context, client qb.Client, files []uploadFile) []FileUploadOutputFile {
+	rids := []int{}
+	byRecord := make(map[int][]uploadFile)
+	for _, f := range files {
+		if _, ok := byRecord[f.recordID]; !ok {
+			rids = append(rids, f.recordID)
+		}
+		byRecord[f.recordID] = append(byRecord[f.recordID], f)
+	}
+
+	results := []FileUploadOutputFile{}
+	for _, rid := range rids {
+		input := &qb.UploadFileInput{
+			TableID:  globalCfg.TableID(),
+			RecordID: rid,
+		}
+
+		sent := []uploadFile{}
+		for _, f := range byRecord[rid] {
+			if err := checkUploadFile(f.path); err != nil {
+				results = append(results, newFileUploadOutputFile(f, "", err))
+				continue
+			}
+
+			input.Fields = append(input.Fields, f.inputField())
+			sent = append(sent, f)
+		}
+
+		if len(sent) == 0 {
+			continue
+		}
+
+		output, err := client.UploadFileWithContext(ctx, input)
+		urls := make(map[int]string, len(output.Fields))
+		for _, field := range output.Fields {
+			urls[field.ID] = field.URL
+		}
+
+		for _, f := range sent {
+			results = append(results, newFileUploadOutputFile(f, urls[f.fieldID], err))
+		}
+	}
+
+	return results
+}
+
+// checkUploadFile returns an error if path isn't a regular file.
+func checkUploadFile(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%s is not a regular file", path)
+	}
 	return nil
 }
+
+// renderUploadResults renders the result of each file and exits with a
+// non-zero status if any file failed to upload.
+func renderUploadResults(results []FileUploadOutputFile) {
+	if globalCfg.Batch() {
+		render(results)
+	} else {
+		render(FileUploadOutput{Files: results})
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		err := fmt.Errorf("%d of %d files failed to upload", failed, len(results))
+		cliutil.HandleError(err, "error uploading files")
+	}
+}
+
+// FileUploadOutput models the output printed after files are uploaded.
+type FileUploadOutput struct {
+	Files []FileUploadOutputFile `json:"files"`
+}
+
+// FileUploadOutputFile models the result of uploading a file.
+type FileUploadOutputFile struct {
+	RecordID int    `json:"record_id"`
+	FieldID  int    `json:"field_id"`
+	Path     string `json:"path"`
+	FileName string `json:"file_name"`
+	URL      string `json:"url,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// newFileUploadOutputFile returns the result of uploading the file.
+func newFileUploadOutputFile(f uploadFile, url string, err error) FileUploadOutputFile {
+	out := FileUploadOutputFile{
+		RecordID: f.recordID,
+		FieldID:  f.fieldID,
+		Path:     f.path,
+		FileName: f.name,
+		URL:      url,
+	}
+	if err != nil {
+		out.Error = err.Error()
+	}
+	return out
+}
@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// withUploadFieldID sets the --field-id option for the duration of fn.
+func withUploadFieldID(fid int, fn func()) {
+	fileUploadCfg.Set("field-id", fid)
+	defer fileUploadCfg.Set("field-id", 0)
+	fn()
+}
+
+// tempUploadDir creates a directory containing the named files.
+func tempUploadDir(t *testing.T, names ...string) string {
+	dir, err := ioutil.TempDir("", "file-upload-")
+	if err != nil {
+		t.Fatalf("error creating directory: %s", err)
+	}
+	for _, name := range names {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(name), 0644); err != nil {
+			t.Fatalf("error writing file: %s", err)
+		}
+	}
+	return dir
+}
+
+func TestParseUploadArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		fieldID int
+		args    []string
+		files   []uploadFile
+		err     bool
+	}{
+		{
+			name:  "field ID pairs",
+			args:  []string{"9=/tmp/a.pdf", "10=file:///tmp/b.pdf"},
+			files: []uploadFile{{12, 9, "/tmp/a.pdf", "a.pdf"}, {12, 10, "/tmp/b.pdf", "b.pdf"}},
+		},
+		{
+			name:    "field-id option",
+			fieldID: 9,
+			args:    []string{"/tmp/a.pdf"},
+			files:   []uploadFile{{12, 9, "/tmp/a.pdf", "a.pdf"}},
+		},
+		{
+			name: "missing field-id option",
+			args: []string{"/tmp/a.pdf"},
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		withUploadFieldID(tt.fieldID, func() {
+			files, err := parseUploadArgs(12, tt.args)
+			if (err != nil) != tt.err {
+				t.Fatalf("%s: unexpected error: %v", tt.name, err)
+			}
+			if !tt.err && !reflect.DeepEqual(files, tt.files) {
+				t.Errorf("%s: expected %+v, got %+v", tt.name, tt.files, files)
+			}
+		})
+	}
+}
+
+func TestMatchUploadFiles(t *testing.T) {
+	dir := tempUploadDir(t, "12-report.pdf", "13.txt", "notes.txt", ".14-hidden.pdf", "15-f7-photo.jpg")
+	defer os.RemoveAll(dir)
+	if err := os.Mkdir(filepath.Join(dir, "16-dir"), 0755); err != nil {
+		t.Fatalf("error creating directory: %s", err)
+	}
+
+	tests := []struct {
+		name    string
+		pattern string
+		fieldID int
+		files   []uploadFile
+	}{
+		{
+			name:    "default pattern",
+			pattern: defaultUploadPattern,
+			fieldID: 9,
+			files: []uploadFile{
+				{12, 9, filepath.Join(dir, "12-report.pdf"), "12-report.pdf"},
+				{13, 9, filepath.Join(dir, "13.txt"), "13.txt"},
+				{15, 9, filepath.Join(dir, "15-f7-photo.jpg"), "15-f7-photo.jpg"},
+			},
+		},
+		{
+			name:    "fid group falls back to field-id option",
+			pattern: `^(?P<rid>\d+)-(?:f(?P<fid>\d+)-)?`,
+			fieldID: 9,
+			files: []uploadFile{
+				{12, 9, filepath.Join(dir, "12-report.pdf"), "12-report.pdf"},
+				{15, 7, filepath.Join(dir, "15-f7-photo.jpg"), "15-f7-photo.jpg"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		withUploadFieldID(tt.fieldID, func() {
+			files, err := matchUploadFiles(dir, tt.pattern)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %s", tt.name, err)
+			}
+			if !reflect.DeepEqual(files, tt.files) {
+				t.Errorf("%s: expected %+v, got %+v", tt.name, tt.files, files)
+			}
+		})
+	}
+}
+
+func TestReadUploadManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		dir      string
+		fieldID  int
+		files    func(dir string) []uploadFile
+		err      bool
+	}{
+		{
+			name:     "relative to the manifest",
+			manifest: "record_id,field_id,path,file_name\n12,9,a.pdf,\n13,10,/tmp/b.pdf,Report.pdf\n",
+			files: func(dir string) []uploadFile {
+				return []uploadFile{
+					{12, 9, filepath.Join(dir, "a.pdf"), "a.pdf"},
+					{13, 10, "/tmp/b.pdf", "Report.pdf"},
+				}
+			},
+		},
+		{
+			name:     "relative to the dir option",
+			manifest: "path,record_id\na.pdf,12\n",
+			dir:      "/data",
+			fieldID:  9,
+			files: func(dir string) []uploadFile {
+				return []uploadFile{{12, 9, "/data/a.pdf", "a.pdf"}}
+			},
+		},
+		{
+			name:     "field_id falls back to field-id option",
+			manifest: "record_id,field_id,path\n12,,a.pdf\n",
+			fieldID:  9,
+			files: func(dir string) []uploadFile {
+				return []uploadFile{{12, 9, filepath.Join(dir, "a.pdf"), "a.pdf"}}
+			},
+		},
+		{
+			name:     "missing field ID",
+			manifest: "record_id,path\n12,a.pdf\n",
+			err:      true,
+		},
+		{
+			name:     "missing path column",
+			manifest: "record_id,field_id\n12,9\n",
+			err:      true,
+		},
+		{
+			name:     "missing record_id column",
+			manifest: "field_id,path\n9,a.pdf\n",
+			err:      true,
+		},
+		{
+			name:     "invalid record ID",
+			manifest: "record_id,field_id,path\nabc,9,a.pdf\n",
+			err:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		dir := tempUploadDir(t)
+		manifest := filepath.Join(dir, "manifest.csv")
+		if err := ioutil.WriteFile(manifest, []byte(tt.manifest), 0644); err != nil {
+			t.Fatalf("error writing manifest: %s", err)
+		}
+
+		withUploadFieldID(tt.fieldID, func() {
+			files, err := readUploadManifest(manifest, tt.dir)
+			if (err != nil) != tt.err {
+				t.Errorf("%s: unexpected error: %v", tt.name, err)
+			} else if !tt.err && !reflect.DeepEqual(files, tt.files(dir)) {
+				t.Errorf("%s: expected %+v, got %+v", tt.name, tt.files(dir), files)
+			}
+		})
+		os.RemoveAll(dir)
+	}
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var importListCfg *viper.Viper
+
+var importListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists saved imports",
+	Long: `Lists the imports saved in the table passed via --table-id. Pass --all-tables
+to list the imports saved in every table of the application passed via
+--app-id instead. The imports are read from the tables' schemas, and each
+import's ID and table ID can be passed to "import run".`,
+	Args: importListCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		allTables := importListCfg.GetBool("all-tables")
+		imports, err := listImports(ctx, client, globalCfg.AppID(), globalCfg.TableID(), allTables)
+		cliutil.HandleError(err, "error executing request")
+
+		render(ImportListOutput{Imports: imports})
+	},
+}
+
+func init() {
+	importCmd.AddCommand(importListCmd)
+	importListCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(importListCmd, importListCfg)
+	flags.Bool("all-tables", "a", false, "list the imports saved in every table of the application")
+}
+
+func importListCmdValidate(cmd *cobra.Command, args []string) error {
+	if importListCfg.GetBool("all-tables") {
+		globalCfg.RequireAppID = true
+	} else {
+		globalCfg.RequireTableID = true
+	}
+	return globalCfg.Validate()
+}
+
+// listImports returns the imports saved in the table, or in every table of the
+// application if allTables is true.
+func listImports(ctx context.Context, client qb.Client, appID, tableID string, allTables bool) ([]ImportListOutputImport, error) {
+	tableIDs := []string{tableID}
+	if allTables {
+		output, err := client.GetSchemaWithContext(ctx, &qb.GetSchemaInput{ID: appID})
+		if err != nil {
+			return nil, err
+		}
+
+		tableIDs = make([]string, len(output.ChildTables))
+		for k, t := range output.ChildTables {
+			tableIDs[k] = t.TableID
+		}
+	}
+
+	imports := []ImportListOutputImport{}
+	for _, id := range tableIDs {
+		output, err := client.GetSchemaWithContext(ctx, &qb.GetSchemaInput{ID: id})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range output.Imports {
+			imports = append(imports, ImportListOutputImport{
+				ImportID:  i.ImportID,
+				Name:      i.Name,
+				TableID:   id,
+				TableName: output.Name,
+			})
+		}
+	}
+
+	return imports, nil
+}
+
+// ImportListOutput models the output that lists saved imports.
+type ImportListOutput struct {
+	Imports []ImportListOutputImport `json:"imports"`
+}
+
+// ImportListOutputImport models a saved import in ImportListOutput.
+type ImportListOutputImport struct {
+	ImportID  int    `json:"import_id"`
+	Name      string `json:"name"`
+	TableID   string `json:"table_id"`
+	TableName string `json:"table_name"`
+}
+
+// Table implements cliutil.Tabular and renders a row per import.
+func (out ImportListOutput) Table() (header []string, rows [][]string) {
+	header = []string{"ID", "Name", "Table ID", "Table"}
+	rows = make([][]string, len(out.Imports))
+	for k, i := range out.Imports {
+		rows[k] = []string{strconv.Itoa(i.ImportID), i.Name, i.TableID, i.TableName}
+	}
+	return
+}
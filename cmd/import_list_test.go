@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// importSchemaHandler responds to API_GetSchema requests with the schema of an
+// application that has two tables, and records the requested dbids.
+func importSchemaHandler(requested *[]string) http.HandlerFunc {
+	schemas := map[string]string{
+		"bpdhfngx3": `<table><name>Projects</name><chdbids><chdbid name="_dbid_tasks">bpdhfphi2</chdbid><chdbid name="_dbid_projects">bpdhfpq5c</chdbid></chdbids></table>`,
+		"bpdhfphi2": `<table><name>Tasks</name><saved_imports><import id="10">Copy open tasks</import></saved_imports></table>`,
+		"bpdhfpq5c": `<table><name>Projects</name><saved_imports><import id="11">Archive projects</import></saved_imports></table>`,
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/db/")
+		*requested = append(*requested, id)
+		w.Write([]byte(`<qdbapi><action>API_GetSchema</action><errcode>0</errcode>` + schemas[id] + `</qdbapi>`))
+	}
+}
+
+func TestListImports(t *testing.T) {
+	tests := []struct {
+		name      string
+		allTables bool
+		requested []string
+		imports   []ImportListOutputImport
+	}{
+		{
+			name:      "table",
+			requested: []string{"bpdhfphi2"},
+			imports: []ImportListOutputImport{
+				{ImportID: 10, Name: "Copy open tasks", TableID: "bpdhfphi2", TableName: "Tasks"},
+			},
+		},
+		{
+			name:      "all tables",
+			allTables: true,
+			requested: []string{"bpdhfngx3", "bpdhfphi2", "bpdhfpq5c"},
+			imports: []ImportListOutputImport{
+				{ImportID: 10, Name: "Copy open tasks", TableID: "bpdhfphi2", TableName: "Tasks"},
+				{ImportID: 11, Name: "Archive projects", TableID: "bpdhfpq5c", TableName: "Projects"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		var requested []string
+		server, client := newTestClient(importSchemaHandler(&requested))
+
+		imports, err := listImports(context.Background(), client, "bpdhfngx3", "bpdhfphi2", tt.allTables)
+		server.Close()
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tt.name, err)
+		}
+		if !reflect.DeepEqual(requested, tt.requested) {
+			t.Errorf("%s: expected requests for %v, got %v", tt.name, tt.requested, requested)
+		}
+		if !reflect.DeepEqual(imports, tt.imports) {
+			t.Errorf("%s: expected %+v, got %+v", tt.name, tt.imports, imports)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var importRunCfg *viper.Viper
+
+var importRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Runs a saved import",
+	Long: `Runs the import saved in the table passed via --table-id, which is the table
+the records are imported into. Use "import list" to find the import's ID.`,
+	Args: importRunCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.RunImportInput{
+			TableID:  globalCfg.TableID(),
+			ImportID: importRunCfg.GetInt("import-id"),
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.RunImportWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, ImportRunOutput{
+			TableID:  input.TableID,
+			ImportID: input.ImportID,
+			Status:   output.Status,
+		})
+	},
+}
+
+func init() {
+	importCmd.AddCommand(importRunCmd)
+	importRunCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(importRunCmd, importRunCfg)
+	flags.Int("import-id", "i", 0, "ID of the saved import being run")
+}
+
+func importRunCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireTableID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if importRunCfg.GetInt("import-id") <= 0 {
+		return errors.New("missing required option: import-id")
+	}
+
+	return nil
+}
+
+// ImportRunOutput models the output printed after a saved import is run.
+type ImportRunOutput struct {
+	TableID  string `json:"table_id"`
+	ImportID int    `json:"import_id"`
+	Status   string `json:"status"`
+}
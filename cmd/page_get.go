@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var pageGetCfg *viper.Viper
+
+var pageGetCmd = &cobra.Command{
+	Use:   "get [PAGE]",
+	Short: "Gets a page",
+	Long: `Gets the page with the ID or name passed as an argument and writes its
+content to STDOUT, or to the file passed via --output-file. Options that
+control how output is rendered, e.g. --output, only apply with --output-file.`,
+	Args: pageGetCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.GetDBPageInput{
+			AppID:  globalCfg.AppID(),
+			PageID: args[0],
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.GetDBPageWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		path := pageGetCfg.GetString("output-file")
+		if path == "" || path == "-" {
+			fmt.Print(output.Content)
+			return
+		}
+
+		err = ioutil.WriteFile(path, []byte(output.Content), 0644)
+		cliutil.HandleError(err, "error writing page")
+
+		renderResponse(output, PageGetOutput{
+			PageID: args[0],
+			Path:   path,
+			Size:   len(output.Content),
+		})
+	},
+}
+
+func init() {
+	pageCmd.AddCommand(pageGetCmd)
+	pageGetCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(pageGetCmd, pageGetCfg)
+	flags.String("output-file", "o", "", "path the page is saved to, defaults to STDOUT")
+}
+
+func pageGetCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if len(args) < 1 {
+		return errors.New("missing required argument: [PAGE]")
+	}
+
+	// The page is written to STDOUT as-is, so options that control how output
+	// is rendered don't apply.
+	if p := pageGetCfg.GetString("output-file"); p == "" || p == "-" {
+		for _, name := range []string{"filter", "output", "raw", "template"} {
+			if f := cmd.Flags().Lookup(name); f != nil && f.Changed {
+				return fmt.Errorf("%s option cannot be used when writing the page to STDOUT, pass --output-file", name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// PageGetOutput models the output printed after a page is saved to a file.
+type PageGetOutput struct {
+	PageID string `json:"page_id"`
+	Path   string `json:"path"`
+	Size   int    `json:"size"`
+}
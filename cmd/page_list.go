@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var pageListCfg *viper.Viper
+
+var pageListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists an application's pages",
+	Long: `Lists the code pages and Exact Forms in the application passed via --app-id.
+Code pages have a page_type of 1 and Exact Forms have a page_type of 3.`,
+	Args: pageListCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.ListDBPagesInput{AppID: globalCfg.AppID()}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.ListDBPagesWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, PageListOutput{Pages: output.Pages})
+	},
+}
+
+func init() {
+	pageCmd.AddCommand(pageListCmd)
+	pageListCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+}
+
+func pageListCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	return globalCfg.Validate()
+}
+
+// PageListOutput models the output that lists an application's pages.
+type PageListOutput struct {
+	Pages []qb.ListDBPagesOutputPage `json:"pages"`
+}
+
+// Table implements cliutil.Tabular and renders a row per page.
+func (out PageListOutput) Table() (header []string, rows [][]string) {
+	header = []string{"ID", "Type", "Name"}
+	rows = make([][]string, len(out.Pages))
+	for k, p := range out.Pages {
+		rows[k] = []string{strconv.Itoa(p.PageID), pageTypeName(p.Type), p.Name}
+	}
+	return
+}
+
+// pageTypeName returns a human readable name of the page type.
+func pageTypeName(t int) string {
+	switch t {
+	case qb.PageTypeCode:
+		return "code"
+	case qb.PageTypeExactForm:
+		return "exact form"
+	}
+	return strconv.Itoa(t)
+}
@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// page* constants contain the statuses reported by "page push".
+const (
+	pageCreated   = "created"
+	pageReplaced  = "replaced"
+	pageUnchanged = "unchanged"
+	pageFailed    = "failed"
+)
+
+var pagePushCfg *viper.Viper
+
+var pagePushCmd = &cobra.Command{
+	Use:   "push [DIR]",
+	Short: "Publishes a directory of pages",
+	Long: `Publishes the files in DIR as pages in the application passed via --app-id,
+e.g. code pages kept in version control. Each file is published as the page
+with the same name, which is replaced if its content changed or created as a
+code page if it doesn't exist. Subdirectories and hidden files are skipped, and
+pages without a matching file are left as is.
+
+The status of each page is reported as "created", "replaced", "unchanged", or
+"failed", and the command exits with a non-zero status if any page fails. Pass
+--dry-run to report what would change without publishing anything.`,
+	Args: pagePushCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		paths, err := readPageDir(args[0])
+		cliutil.HandleError(err, "error reading directory")
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		list, err := client.ListDBPagesWithContext(ctx, &qb.ListDBPagesInput{AppID: globalCfg.AppID()})
+		cliutil.HandleError(err, "error executing request")
+
+		existing := make(map[string]qb.ListDBPagesOutputPage, len(list.Pages))
+		for _, p := range list.Pages {
+			existing[p.Name] = p
+		}
+
+		dryRun := pagePushCfg.GetBool("dry-run")
+		out := PagePushOutput{DryRun: dryRun, Pages: make([]PagePushOutputPage, len(paths))}
+		failed := 0
+		for k, path := range paths {
+			page, ok := existing[filepath.Base(path)]
+			if !ok {
+				page = qb.ListDBPagesOutputPage{Type: qb.PageTypeCode, Name: filepath.Base(path)}
+			}
+
+			out.Pages[k] = pushPage(ctx, client, page, path, dryRun)
+			if out.Pages[k].Status == pageFailed {
+				failed++
+			}
+		}
+
+		render(out)
+
+		if failed > 0 {
+			err := fmt.Errorf("%d of %d pages failed to publish", failed, len(paths))
+			cliutil.HandleError(err, "error publishing pages")
+		}
+	},
+}
+
+func init() {
+	pageCmd.AddCommand(pagePushCmd)
+	pagePushCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(pagePushCmd, pagePushCfg)
+	flags.Bool("dry-run", "n", false, "report what would change without publishing")
+}
+
+func pagePushCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if len(args) < 1 {
+		return errors.New("missing required argument: [DIR]")
+	}
+
+	return nil
+}
+
+// readPageDir returns the paths of the files in dir, skipping subdirectories
+// and hidden files.
+func readPageDir(dir string) ([]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{}
+	for _, info := range infos {
+		if !info.Mode().IsRegular() || strings.HasPrefix(info.Name(), ".") {
+			continue
+		}
+		paths = append(paths, filepath.Join(dir, info.Name()))
+	}
+	return paths, nil
+}
+
+// pushPage publishes the file at path as the page and returns the result. The
+// page is new if its PageID is zero, otherwise it is only replaced if its
+// content differs from the file.
+func pushPage(ctx context.Context, client qb.Client, page qb.ListDBPagesOutputPage, path string, dryRun bool) PagePushOutputPage {
+	out := PagePushOutputPage{Name: page.Name, PageID: page.PageID, Path: path}
+
+	fail := func(err error) PagePushOutputPage {
+		out.Status = pageFailed
+		out.Error = err.Error()
+		return out
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fail(err)
+	}
+
+	out.Status = pageCreated
+	if page.PageID > 0 {
+		current, err := client.GetDBPageWithContext(ctx, &qb.GetDBPageInput{
+			AppID:  globalCfg.AppID(),
+			PageID: strconv.Itoa(page.PageID),
+		})
+		if err != nil {
+			return fail(err)
+		}
+
+		out.Status = pageReplaced
+		if current.Content == string(b) {
+			out.Status = pageUnchanged
+		}
+	}
+
+	if out.Status == pageUnchanged || dryRun {
+		return out
+	}
+
+	input := &qb.AddReplaceDBPageInput{
+		AppID:    globalCfg.AppID(),
+		PageID:   page.PageID,
+		PageType: page.Type,
+		PageBody: string(b),
+	}
+	if page.PageID == 0 {
+		input.PageName = page.Name
+	}
+
+	output, err := client.AddReplaceDBPageWithContext(ctx, input)
+	if err != nil {
+		return fail(err)
+	}
+
+	out.PageID = output.PageID
+	return out
+}
+
+// PagePushOutput models the output printed after a directory of pages is
+// published.
+type PagePushOutput struct {
+	DryRun bool                 `json:"dry_run,omitempty"`
+	Pages  []PagePushOutputPage `json:"pages"`
+}
+
+// Table implements cliutil.Tabular and renders a row per page.
+func (out PagePushOutput) Table() (header []string, rows [][]string) {
+	header = []string{"Name", "ID", "Status", "Error"}
+	rows = make([][]string, len(out.Pages))
+	for k, p := range out.Pages {
+		id := ""
+		if p.PageID > 0 {
+			id = strconv.Itoa(p.PageID)
+		}
+		rows[k] = []string{p.Name, id, p.Status, p.Error}
+	}
+	return
+}
+
+// PagePushOutputPage models the result of publishing a page.
+type PagePushOutputPage struct {
+	Name   string `json:"name"`
+	PageID int    `json:"page_id,omitempty"`
+	Path   string `json:"path"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"os"
 	"strconv"
 
 	"github.com/cpliakas/quickbase-do-query/cliutil"
@@ -19,46 +22,42 @@ var doQueryCmd = &cobra.Command{
 	Long:  ``,
 	Args:  doQueryCmdValidate,
 	Run: func(cmd *cobra.Command, args []string) {
-		input := &qb.DoQueryInput{}
-		input.TableID = globalCfg.TableID()
-
-		query := doQueryCfg.GetString("query")
-		queryID := doQueryCfg.GetInt("query-id")
-		queryName := doQueryCfg.GetString("query-name")
-
-		if query != "" {
-			input.Query = query
-		} else if queryID > 0 {
-			input.QueryID = queryID
-		} else if queryName != "" {
-			input.QueryName = queryName
-		} else {
-			err := errors.New("query, query-id, or query-name")
-			cliutil.HandleError(err, "missing required option")
-		}
+		input := newDoQueryInput(doQueryCfg)
 
 		// TODO: Support these options.
 		// Unsorted()
 		// OnlyNew()
 		// ReturnPercentage()
 
-		fields, err := qbutil.ParseFieldsOption(doQueryCfg.GetString("fields"))
-		cliutil.HandleError(err, "fields option invalid")
-		input.FieldList = fields
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		useLabels := doQueryCfg.GetBool("use-labels")
 
-		sort, order, err := qbutil.ParseSortOption(doQueryCfg.GetString("sort"))
-		cliutil.HandleError(err, "sort option invalid")
-		input.Sort(sort, order)
+		if doQueryCfg.GetBool("count") {
+			countRecords(ctx, client, input)
+			return
+		}
 
 		input.Offset(doQueryCfg.GetInt("offset"))
-		input.Limit(doQueryCfg.GetInt("limit"))
+		if doQueryCfg.GetBool("all") {
+			input.Limit(doQueryCfg.GetInt("page-size"))
+			queryAll(ctx, client, input, useLabels)
+			return
+		}
 
-		client := qb.NewClient(globalCfg)
-		output, err := client.DoQuery(input)
+		input.Limit(doQueryCfg.GetInt("limit"))
+		output, err := client.DoQueryWithContext(ctx, input)
 		cliutil.HandleError(err, "error executing request")
 
-		v := newDoQueryOutput(output, doQueryCfg.GetBool("use-labels"))
-		cliutil.PrintJSON(v)
+		// In batch mode, render one record per line so that the records can be
+		// piped to other commands.
+		v := newDoQueryOutput(output, useLabels)
+		if globalCfg.Batch() {
+			renderResponse(output, v.Records)
+		} else {
+			renderResponse(output, v)
+		}
 	},
 }
 
@@ -67,19 +66,135 @@ func init() {
 	doQueryCfg = cliutil.InitConfig(qb.EnvVarPrefix)
 
 	flags := cliutil.NewFlagger(doQueryCmd, doQueryCfg)
-	flags.String("fields", "f", "", "comma-delimited list of fields to return")
-	flags.Int("limit", "l", 25, "maximum number of records to return")
+	addDoQueryFlags(flags)
+	flags.Bool("all", "a", false, "return all records, requesting them a page at a time")
+	flags.Bool("count", "c", false, "return the number of records matched by the query instead of the records")
+	flags.Int("limit", "l", 25, "maximum number of records to return, ignored if --all is passed")
 	flags.Int("offset", "o", 0, "number of records to skip")
+	flags.Int("page-size", "p", qb.DefaultPageSize, "number of records requested per page when --all is passed")
+	flags.Bool("use-labels", "u", false, "key by label instead of field ID")
+}
+
+// addDoQueryFlags adds the options that select, return, and sort records.
+func addDoQueryFlags(flags *cliutil.Flagger) {
+	flags.String("fields", "f", "", "comma-delimited list of fields to return")
+	addQueryFlags(flags)
+	flags.String("sort", "s", "", "comma-delimited list of fields to sort by")
+}
+
+// addQueryFlags adds the options that select records.
+func addQueryFlags(flags *cliutil.Flagger) {
 	flags.String("query", "q", "", "query that gets records from the table")
 	flags.String("query-id", "i", "", "ID of the query that gets records from the table")
 	flags.String("query-name", "n", "", "name of the query that gets records from the table")
-	flags.String("sort", "s", "", "comma-delimited list of fields to sort by")
-	flags.Bool("use-labels", "u", false, "key by label instead of field ID")
+}
+
+// parseQueryFlags returns the query, query ID, and query name passed via the
+// options added by addQueryFlags. Only one of them is returned, in that order
+// of preference.
+func parseQueryFlags(cfg *viper.Viper) (query string, queryID int, queryName string) {
+	if query = cfg.GetString("query"); query != "" {
+		return
+	}
+	if queryID = cfg.GetInt("query-id"); queryID > 0 {
+		return
+	}
+	if queryName = cfg.GetString("query-name"); queryName != "" {
+		return
+	}
+
+	err := errors.New("query, query-id, or query-name")
+	cliutil.HandleError(err, "missing required option")
+	return
+}
+
+// newDoQueryInput returns a *qb.DoQueryInput populated with the options
+// added by addDoQueryFlags.
+func newDoQueryInput(cfg *viper.Viper) *qb.DoQueryInput {
+	input := &qb.DoQueryInput{}
+	input.TableID = globalCfg.TableID()
+	input.Query, input.QueryID, input.QueryName = parseQueryFlags(cfg)
+
+	fields, err := qbutil.ParseFieldsOption(cfg.GetString("fields"))
+	cliutil.HandleError(err, "fields option invalid")
+	input.FieldList = fields
+
+	sort, order, err := qbutil.ParseSortOption(cfg.GetString("sort"))
+	cliutil.HandleError(err, "sort option invalid")
+	input.Sort(sort, order)
+
+	return input
 }
 
 func doQueryCmdValidate(cmd *cobra.Command, args []string) error {
 	globalCfg.RequireTableID = true
-	return globalCfg.Validate()
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if doQueryCfg.GetBool("all") && doQueryCfg.GetInt("page-size") <= 0 {
+		return errors.New("page-size option invalid: must be greater than 0")
+	}
+
+	return nil
+}
+
+// countRecords renders the number of records matched by the query.
+func countRecords(ctx context.Context, client qb.Client, input *qb.DoQueryInput) {
+	output, err := client.DoQueryCountWithContext(ctx, &qb.DoQueryCountInput{
+		TableID:   input.TableID,
+		Query:     input.Query,
+		QueryID:   input.QueryID,
+		QueryName: input.QueryName,
+	})
+	cliutil.HandleError(err, "error executing request")
+
+	render(output)
+}
+
+// queryAll renders all records matched by the query, requesting them a page
+// at a time. Records are rendered as each page is returned unless all records
+// are needed to evaluate the filter, in which case they are buffered.
+func queryAll(ctx context.Context, client qb.Client, input *qb.DoQueryInput, useLabels bool) {
+	var list *cliutil.ListWriter
+	records := []DoQueryOutputRecord{}
+
+	// Pretty-printed JSON can be streamed as long as all records aren't needed
+	// to evaluate the filter, line-oriented output is rendered page by page,
+	// and all other formats need the full result set.
+	format := globalCfg.RenderOptions().FormatName()
+	perPage := globalCfg.Raw() || format == cliutil.FormatNameNDJSON
+	stream := !perPage && format == cliutil.FormatNameJSON && globalCfg.Filter() == ""
+	if stream {
+		list = cliutil.NewListWriter(os.Stdout, "records")
+	}
+
+	var columns []doQueryColumn
+	err := client.DoQueryPagesWithContext(ctx, input, func(output qb.DoQueryOutput, lastPage bool) bool {
+		v := newDoQueryOutput(output, useLabels)
+		if columns == nil {
+			columns = v.columns
+		}
+
+		switch {
+		case perPage:
+			renderResponse(output, v.Records)
+		case stream:
+			for _, r := range v.Records {
+				cliutil.HandleError(list.Write(r), "error rendering output")
+			}
+		default:
+			records = append(records, v.Records...)
+		}
+		return true
+	})
+	cliutil.HandleError(err, "error executing request")
+
+	if stream {
+		cliutil.HandleError(list.Close(), "error rendering output")
+	} else if !perPage {
+		render(DoQueryOutput{Records: records, columns: columns})
+	}
 }
 
 // newDoQueryOutput returns a DoQueryOutput.
@@ -88,8 +203,13 @@ func newDoQueryOutput(out qb.DoQueryOutput, useLabels bool) DoQueryOutput {
 	// Build a field map so we can key the field by label.
 	// TODO: Don't build a map
 	fieldMap := make(map[int]string)
-	for _, f := range out.Fields {
+	columns := make([]doQueryColumn, len(out.Fields))
+	for k, f := range out.Fields {
 		fieldMap[f.FieldID] = f.Label
+		columns[k] = doQueryColumn{key: strconv.Itoa(f.FieldID), label: f.Label}
+		if useLabels {
+			columns[k].key = f.Label
+		}
 	}
 
 	// Builds the rendered output.
@@ -114,6 +234,7 @@ func newDoQueryOutput(out qb.DoQueryOutput, useLabels bool) DoQueryOutput {
 	return DoQueryOutput{
 		UserData: out.UserData,
 		Records:  records,
+		columns:  columns,
 	}
 }
 
@@ -121,6 +242,36 @@ func newDoQueryOutput(out qb.DoQueryOutput, useLabels bool) DoQueryOutput {
 type DoQueryOutput struct {
 	UserData string                `json:"user_data,omitempty"`
 	Records  []DoQueryOutputRecord `json:"records"`
+
+	// columns contains the returned fields in the order they were returned.
+	columns []doQueryColumn
+}
+
+// doQueryColumn models a field rendered as a column in tabular formats.
+type doQueryColumn struct {
+	key   string
+	label string
+}
+
+// Table implements cliutil.Tabular and renders a row per record with the
+// field labels as column headers.
+func (out DoQueryOutput) Table() (header []string, rows [][]string) {
+	header = make([]string, len(out.columns))
+	for k, c := range out.columns {
+		header[k] = c.label
+	}
+
+	rows = make([][]string, len(out.Records))
+	for k, r := range out.Records {
+		rows[k] = make([]string, len(out.columns))
+		for i, c := range out.columns {
+			if v, ok := r.Fields[c.key]; ok {
+				rows[k][i] = fmt.Sprint(v)
+			}
+		}
+	}
+
+	return
 }
 
 // DoQueryOutputRecord models the output that prints a record.
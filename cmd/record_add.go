@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"io/ioutil"
@@ -20,27 +21,51 @@ var recordAddCfg *viper.Viper
 var recordAddCmd = &cobra.Command{
 	Use:   "add [FIELD_VALUES]",
 	Short: "Adds a record",
-	Long:  ``,
-	Args:  recordAddCmdValidate,
+	Long: `Adds a record.
+
+In batch mode, a record is added for each line read from STDIN. Each line is a
+JSON object in the format rendered by "query --batch", e.g.
+{"fields":{"7":"value"}}. Field values passed as arguments are applied to every
+record.`,
+	Args: recordAddCmdValidate,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		values := cliutil.ParseKeyValue(strings.Join(args, " "))
-		fields, err := parseValues(values)
-		cliutil.HandleError(err, "error parsing field values")
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
 
-		input := &qb.AddRecordInput{
-			TableID: globalCfg.TableID(),
-			Fields:  fields,
+		if !globalCfg.Batch() {
+			addRecord(ctx, client, values)
+			return
 		}
 
-		client := qb.NewClient(globalCfg)
-		output, err := client.AddRecord(input)
-		cliutil.HandleError(err, "error formatting output")
-
-		cliutil.PrintJSON(output)
+		// In batch mode, add a record for each line read from STDIN. Values
+		// passed as arguments are applied to every record.
+		err := scanBatchRecords(func(r batchRecord) error {
+			addRecord(ctx, client, r.values(values))
+			return nil
+		})
+		cliutil.HandleError(err, "error reading records")
 	},
 }
 
+// addRecord adds a record with the passed field values and renders the output.
+func addRecord(ctx context.Context, client qb.Client, values map[string]string) {
+	fields, err := parseValues(values)
+	cliutil.HandleError(err, "error parsing field values")
+
+	input := &qb.AddRecordInput{
+		TableID: globalCfg.TableID(),
+		Fields:  fields,
+	}
+
+	output, err := client.AddRecordWithContext(ctx, input)
+	cliutil.HandleError(err, "error formatting output")
+
+	render(output)
+}
+
 func init() {
 	recordCmd.AddCommand(recordAddCmd)
 	recordAddCfg = cliutil.InitConfig(qb.EnvVarPrefix)
@@ -52,7 +77,7 @@ func recordAddCmdValidate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if len(args) < 1 {
+	if len(args) < 1 && !globalCfg.Batch() {
 		return errors.New("missing required argument: [FIELD_VALUES]")
 	}
 
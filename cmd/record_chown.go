@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var recordChownCfg *viper.Viper
+
+var recordChownCmd = &cobra.Command{
+	Use:   "chown",
+	Short: "Changes the owner of records",
+	Long: `Changes the owner of the record passed via --record-id to the user passed via
+--owner, which is the user's email address or screen name.
+
+If --record-id isn't passed, the owner of every record matched by --query,
+--query-id, or --query-name is changed instead, e.g. to reassign the records
+owned by a user who left. The matching record IDs are read before any owner is
+changed, so queries that filter on the record owner are safe to use.
+
+In batch mode, the owner of the record identified by each line read from STDIN
+is changed. Each line is a JSON object in the format rendered by
+"query --batch", e.g. {"record_id":1,"fields":{"7":"value"}}.
+
+When changing multiple records, the result is reported per record, and the
+command exits with a non-zero status if any record fails.`,
+	Args: recordChownCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		owner := recordChownCfg.GetString("owner")
+		if rid := recordChownCfg.GetInt("record-id"); rid > 0 {
+			out := changeRecordOwner(ctx, client, rid, owner)
+			if out.Error != "" {
+				cliutil.HandleError(errors.New(out.Error), "error executing request")
+			}
+			render(out)
+			return
+		}
+
+		rids := []int{}
+		if globalCfg.Batch() {
+			err := scanBatchRecords(func(r batchRecord) error {
+				if r.ID <= 0 {
+					return errors.New("record_id missing from record")
+				}
+				rids = append(rids, r.ID)
+				return nil
+			})
+			cliutil.HandleError(err, "error reading records")
+		} else {
+			input := &qb.DoQueryInput{TableID: globalCfg.TableID()}
+			input.Query, input.QueryID, input.QueryName = parseQueryFlags(recordChownCfg)
+			input.FieldList = qb.FieldList{3}
+
+			err := client.DoQueryPagesWithContext(ctx, input, func(output qb.DoQueryOutput, lastPage bool) bool {
+				for _, r := range output.Records {
+					rids = append(rids, r.RecordID)
+				}
+				return true
+			})
+			cliutil.HandleError(err, "error executing request")
+		}
+
+		results := make([]RecordChownOutput, len(rids))
+		failed := 0
+		for k, rid := range rids {
+			results[k] = changeRecordOwner(ctx, client, rid, owner)
+			if results[k].Error != "" {
+				failed++
+			}
+		}
+
+		if globalCfg.Batch() {
+			render(results)
+		} else {
+			render(RecordChownListOutput{Records: results})
+		}
+
+		if failed > 0 {
+			err := fmt.Errorf("%d of %d records failed", failed, len(results))
+			cliutil.HandleError(err, "error changing record owners")
+		}
+	},
+}
+
+// changeRecordOwner changes the owner of the record and returns the result.
+func changeRecordOwner(ctx context.Context, client qb.Client, rid int, owner string) RecordChownOutput {
+	input := &qb.ChangeRecordOwnerInput{
+		TableID:  globalCfg.TableID(),
+		RecordID: rid,
+		NewOwner: owner,
+	}
+
+	out := RecordChownOutput{RecordID: rid, Owner: owner}
+	if _, err := client.ChangeRecordOwnerWithContext(ctx, input); err != nil {
+		out.Error = err.Error()
+	}
+	return out
+}
+
+func init() {
+	recordCmd.AddCommand(recordChownCmd)
+	recordChownCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(recordChownCmd, recordChownCfg)
+	flags.String("owner", "o", "", "email address or screen name of the new owner")
+	addQueryFlags(flags)
+	flags.Int("record-id", "r", 0, "ID of the record whose owner is changed")
+}
+
+func recordChownCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireTableID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if recordChownCfg.GetString("owner") == "" {
+		return errors.New("missing required option: owner")
+	}
+
+	return nil
+}
+
+// RecordChownOutput models the result of changing a record's owner.
+type RecordChownOutput struct {
+	RecordID int    `json:"record_id"`
+	Owner    string `json:"owner"`
+	Error    string `json:"error,omitempty"`
+}
+
+// RecordChownListOutput models the output printed after the owners of
+// multiple records are changed.
+type RecordChownListOutput struct {
+	Records []RecordChownOutput `json:"records"`
+}
+
+// Table implements cliutil.Tabular and renders a row per record.
+func (out RecordChownListOutput) Table() (header []string, rows [][]string) {
+	header = []string{"Record ID", "Owner", "Error"}
+	rows = make([][]string, len(out.Records))
+	for k, r := range out.Records {
+		rows[k] = []string{strconv.Itoa(r.RecordID), r.Owner, r.Error}
+	}
+	return
+}
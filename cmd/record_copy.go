@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var recordCopyCfg *viper.Viper
+
+var recordCopyCmd = &cobra.Command{
+	Use:   "copy",
+	Short: "Copies a record and its detail records",
+	Long: `Copies the master record passed via --record-id along with its detail records,
+e.g. a project and its tasks. The table passed via --table-id is the master
+table. A new master record is created, and the value of the text field passed
+via --copy-field-id is prefixed with "Copy of" in the new record.
+
+API_CopyMasterDetail can only copy records within the master table, so the
+copy is always created in the table passed via --table-id. --dest-table is
+accepted for scripts that pass it explicitly, but it must be the same table.
+Pass --dest-record-id to copy the detail records to an existing master record
+instead of creating a new one. Pass --relationship-field to only copy the
+detail records of the given relationships, which are the IDs of report link
+fields in the master table separated by commas. All relationships are copied
+by default.
+
+The ID of the new or destination master record is printed along with the
+number of records that were created.`,
+	Args: recordCopyCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.CopyMasterDetailInput{
+			TableID:              globalCfg.TableID(),
+			SourceRecordID:       recordCopyCfg.GetInt("record-id"),
+			DestRecordID:         recordCopyCfg.GetInt("dest-record-id"),
+			CopyFieldID:          recordCopyCfg.GetInt("copy-field-id"),
+			RelationshipFieldIDs: strings.Replace(recordCopyCfg.GetString("relationship-field"), " ", "", -1),
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.CopyMasterDetailWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, RecordCopyOutput{
+			RecordID:    input.SourceRecordID,
+			NewRecordID: output.ParentRecordID,
+			NumCreated:  output.NumCreated,
+		})
+	},
+}
+
+func init() {
+	recordCmd.AddCommand(recordCopyCmd)
+	recordCopyCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(recordCopyCmd, recordCopyCfg)
+	flags.Int("copy-field-id", "c", 0, `text field prefixed with "Copy of" in the new record`)
+	flags.Int("dest-record-id", "d", 0, "ID of the master record the detail records are copied to")
+	flags.String("dest-table", "", "", "table the copy is created in, which must be the master table")
+	flags.Int("record-id", "r", 0, "ID of the master record being copied")
+	flags.String("relationship-field", "f", "", "comma-separated IDs of the report link fields to copy, defaults to all")
+}
+
+func recordCopyCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireTableID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if recordCopyCfg.GetInt("record-id") <= 0 {
+		return errors.New("missing required option: record-id")
+	}
+	if t := recordCopyCfg.GetString("dest-table"); t != "" && t != globalCfg.TableID() {
+		return fmt.Errorf("dest-table option invalid: records can only be copied within the master table %s", globalCfg.TableID())
+	}
+	if recordCopyCfg.GetInt("dest-record-id") < 0 {
+		return errors.New("dest-record-id option invalid: must not be negative")
+	}
+	if recordCopyCfg.GetInt("dest-record-id") == 0 && recordCopyCfg.GetInt("copy-field-id") <= 0 {
+		return errors.New("missing required option: copy-field-id")
+	}
+
+	if fids := recordCopyCfg.GetString("relationship-field"); fids != "" && fids != "all" {
+		for _, s := range strings.Split(fids, ",") {
+			if id, err := strconv.Atoi(strings.TrimSpace(s)); err != nil || id <= 0 {
+				return errors.New("relationship-field option invalid: must be field IDs separated by commas")
+			}
+		}
+	}
+
+	return nil
+}
+
+// RecordCopyOutput models the output printed after a record is copied.
+type RecordCopyOutput struct {
+	RecordID    int `json:"record_id"`
+	NewRecordID int `json:"new_record_id"`
+	NumCreated  int `json:"num_created"`
+}
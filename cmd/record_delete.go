@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var recordDeleteCfg *viper.Viper
+
+var recordDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Deletes a record",
+	Long: `Deletes a record.
+
+In batch mode, the record identified by each line read from STDIN is deleted.
+Each line is a JSON object in the format rendered by "query --batch", e.g.
+{"record_id":1,"fields":{"7":"value"}}.`,
+	Args: recordDeleteCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		if !globalCfg.Batch() {
+			deleteRecord(ctx, client, recordDeleteCfg.GetInt("record-id"))
+			return
+		}
+
+		err := scanBatchRecords(func(r batchRecord) error {
+			if r.ID <= 0 {
+				return errors.New("record_id missing from record")
+			}
+			deleteRecord(ctx, client, r.ID)
+			return nil
+		})
+		cliutil.HandleError(err, "error reading records")
+	},
+}
+
+// deleteRecord deletes a record and renders the output.
+func deleteRecord(ctx context.Context, client qb.Client, rid int) {
+	input := &qb.DeleteRecordInput{
+		TableID:  globalCfg.TableID(),
+		RecordID: rid,
+	}
+
+	output, err := client.DeleteRecordWithContext(ctx, input)
+	cliutil.HandleError(err, "error executing request")
+
+	render(output)
+}
+
+func init() {
+	recordCmd.AddCommand(recordDeleteCmd)
+	recordDeleteCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(recordDeleteCmd, recordDeleteCfg)
+	flags.Int("record-id", "r", 0, "ID of the record being deleted")
+}
+
+func recordDeleteCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireTableID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if recordDeleteCfg.GetInt("record-id") <= 0 && !globalCfg.Batch() {
+		return errors.New("missing required option: record-id")
+	}
+
+	return nil
+}
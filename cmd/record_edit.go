@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"io/ioutil"
@@ -20,28 +21,56 @@ var recordEditCfg *viper.Viper
 var recordEditCmd = &cobra.Command{
 	Use:   "edit [FIELD_VALUES]",
 	Short: "Edits a record",
-	Long:  ``,
-	Args:  recordEditCmdValidate,
+	Long: `Edits a record.
+
+In batch mode, the record identified by each line read from STDIN is edited.
+Each line is a JSON object in the format rendered by "query --batch", e.g.
+{"record_id":1,"fields":{"7":"value"}}. Field values passed as arguments are
+applied to every record and take precedence over the values in the line.`,
+	Args: recordEditCmdValidate,
 	Run: func(cmd *cobra.Command, args []string) {
 
 		values := cliutil.ParseKeyValue(strings.Join(args, " "))
-		fields, err := parseEditValues(values)
-		cliutil.HandleError(err, "error parsing field values")
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
 
-		input := &qb.EditRecordInput{
-			TableID:  globalCfg.TableID(),
-			RecordID: recordEditCfg.GetInt("record-id"),
-			Fields:   fields,
+		if !globalCfg.Batch() {
+			editRecord(ctx, client, recordEditCfg.GetInt("record-id"), values)
+			return
 		}
 
-		client := qb.NewClient(globalCfg)
-		output, err := client.EditRecord(input)
-		cliutil.HandleError(err, "error formatting output")
-
-		cliutil.PrintJSON(output)
+		// In batch mode, edit the record identified by each line read from
+		// STDIN. Values passed as arguments are applied to every record.
+		err := scanBatchRecords(func(r batchRecord) error {
+			if r.ID <= 0 {
+				return errors.New("record_id missing from record")
+			}
+			editRecord(ctx, client, r.ID, r.values(values))
+			return nil
+		})
+		cliutil.HandleError(err, "error reading records")
 	},
 }
 
+// editRecord edits a record with the passed field values and renders the
+// output.
+func editRecord(ctx context.Context, client qb.Client, rid int, values map[string]string) {
+	fields, err := parseEditValues(values)
+	cliutil.HandleError(err, "error parsing field values")
+
+	input := &qb.EditRecordInput{
+		TableID:  globalCfg.TableID(),
+		RecordID: rid,
+		Fields:   fields,
+	}
+
+	output, err := client.EditRecordWithContext(ctx, input)
+	cliutil.HandleError(err, "error formatting output")
+
+	render(output)
+}
+
 func init() {
 	recordCmd.AddCommand(recordEditCmd)
 	recordEditCfg = cliutil.InitConfig(qb.EnvVarPrefix)
@@ -56,6 +85,11 @@ func recordEditCmdValidate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// In batch mode, the record IDs and field values are read from STDIN.
+	if globalCfg.Batch() {
+		return nil
+	}
+
 	if len(args) < 1 {
 		return errors.New("missing required argument: [FIELD_VALUES]")
 	}
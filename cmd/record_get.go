@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var recordGetCfg *viper.Viper
+
+var recordGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Gets a record",
+	Long: `Gets a record and prints its fields keyed by field ID, or by label if
+--use-labels is passed. The record is printed in the same format as the records
+returned by the "query" command, so in batch mode it can be piped to commands
+such as "record edit --batch".
+
+Use --output=table to print a row per field including its label and type.`,
+	Args: recordGetCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.GetRecordInfoInput{
+			TableID:  globalCfg.TableID(),
+			RecordID: recordGetCfg.GetInt("record-id"),
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		output, err := client.GetRecordInfoWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, newRecordGetOutput(output, recordGetCfg.GetBool("use-labels")))
+	},
+}
+
+func init() {
+	recordCmd.AddCommand(recordGetCmd)
+	recordGetCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(recordGetCmd, recordGetCfg)
+	flags.Int("record-id", "r", 0, "ID of the record being retrieved")
+	flags.Bool("use-labels", "u", false, "key by label instead of field ID")
+}
+
+func recordGetCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireTableID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if recordGetCfg.GetInt("record-id") <= 0 {
+		return errors.New("missing required option: record-id")
+	}
+
+	return nil
+}
+
+// newRecordGetOutput returns a RecordGetOutput.
+func newRecordGetOutput(out qb.GetRecordInfoOutput, useLabels bool) RecordGetOutput {
+	record := DoQueryOutputRecord{
+		ID:       out.RecordID,
+		UpdateID: out.UpdateID,
+		Fields:   make(map[string]interface{}, len(out.Fields)),
+	}
+
+	for _, f := range out.Fields {
+		if useLabels {
+			record.Fields[f.Name] = f.Value
+		} else {
+			record.Fields[strconv.Itoa(f.FieldID)] = f.Value
+		}
+	}
+
+	return RecordGetOutput{
+		DoQueryOutputRecord: record,
+		fields:              out.Fields,
+	}
+}
+
+// RecordGetOutput models the output that prints a record.
+type RecordGetOutput struct {
+	DoQueryOutputRecord
+
+	// fields contains the field metadata returned with the record.
+	fields []qb.GetRecordInfoOutputField
+}
+
+// Table implements cliutil.Tabular and renders a row per field.
+func (out RecordGetOutput) Table() (header []string, rows [][]string) {
+	header = []string{"ID", "Label", "Type", "Value"}
+	rows = make([][]string, len(out.fields))
+	for k, f := range out.fields {
+		rows[k] = []string{strconv.Itoa(f.FieldID), f.Name, f.Type, f.Value}
+	}
+	return
+}
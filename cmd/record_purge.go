@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var recordPurgeCfg *viper.Viper
+
+var recordPurgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Deletes all records matched by a query",
+	Long: `Deletes all records matched by a query. Deleted records cannot be recovered.
+
+The number of records matched by the query is shown, and the records are only
+deleted after confirming at the prompt or if --yes is passed.`,
+	Args: recordPurgeCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		input := &qb.PurgeRecordsInput{TableID: globalCfg.TableID()}
+		input.Query, input.QueryID, input.QueryName = parseQueryFlags(recordPurgeCfg)
+
+		count, err := client.DoQueryCountWithContext(ctx, &qb.DoQueryCountInput{
+			TableID:   input.TableID,
+			Query:     input.Query,
+			QueryID:   input.QueryID,
+			QueryName: input.QueryName,
+		})
+		cliutil.HandleError(err, "error counting records")
+
+		// There is nothing to do if no records match.
+		if count.NumMatches == 0 {
+			render(qb.PurgeRecordsOutput{})
+			return
+		}
+
+		if !recordPurgeCfg.GetBool("yes") {
+			prompt := fmt.Sprintf("Permanently delete %v record(s) matched by the query?", count.NumMatches)
+			ok, err := cliutil.Confirm(prompt)
+			cliutil.HandleError(err, "error confirming purge, pass --yes to skip confirmation")
+			if !ok {
+				cliutil.HandleError(errors.New("purge canceled"), "")
+			}
+		}
+
+		output, err := client.PurgeRecordsWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		render(output)
+	},
+}
+
+func init() {
+	recordCmd.AddCommand(recordPurgeCmd)
+	recordPurgeCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(recordPurgeCmd, recordPurgeCfg)
+	addQueryFlags(flags)
+	flags.Bool("yes", "y", false, "delete the records without prompting for confirmation")
+}
+
+func recordPurgeCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireTableID = true
+	return globalCfg.Validate()
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var roleAddCfg *viper.Viper
+
+var roleAddCmd = &cobra.Command{
+	Use:   "add [USER...]",
+	Short: "Adds users to a role",
+	Long: `Adds users to the role passed via --role-id in the application passed via
+--app-id. Users are identified by their user ID or email address, and they
+must already be registered with Quick Base. Use "user provision" to add new
+users instead.
+
+Pass --csv-file to add the users in a CSV file instead, which has a "user_id" or
+"email" column and an optional "role_id" column that defaults to --role-id.
+The result is reported per user, and the command exits with a non-zero status
+if any user fails.`,
+	Args: roleAddCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		users, err := readUsers(roleAddCfg.GetString("csv-file"), args, userRow{
+			RoleID: roleAddCfg.GetInt("role-id"),
+		})
+		cliutil.HandleError(err, "error reading users")
+		cliutil.HandleError(checkUserRoles(users), "error reading users")
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		results := applyUsers(ctx, client, users, true, func(u *userRow) error {
+			_, err := client.AddUserToRoleWithContext(ctx, &qb.AddUserToRoleInput{
+				AppID:  globalCfg.AppID(),
+				UserID: u.UserID,
+				RoleID: u.RoleID,
+			})
+			return err
+		})
+
+		renderUserResults(results)
+	},
+}
+
+func init() {
+	roleCmd.AddCommand(roleAddCmd)
+	roleAddCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(roleAddCmd, roleAddCfg)
+	flags.String("csv-file", "c", "", "path to a CSV file containing the users")
+	flags.Int("role-id", "r", 0, "ID of the role the users are added to")
+}
+
+func roleAddCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if roleAddCfg.GetString("csv-file") != "" {
+		if len(args) > 0 {
+			return errors.New("arguments cannot be used with the csv-file option")
+		}
+	} else if len(args) < 1 {
+		return errors.New("missing required argument: [USER]")
+	}
+
+	return nil
+}
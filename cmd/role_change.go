@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var roleChangeCfg *viper.Viper
+
+var roleChangeCmd = &cobra.Command{
+	Use:   "change [USER...]",
+	Short: "Changes users' role",
+	Long: `Moves users from the role passed via --role-id to the role passed via
+--new-role-id in the application passed via --app-id. Users are identified by
+their user ID or email address. If --new-role-id isn't passed, the users' role
+is set to "None", which disables their access to the application while keeping
+them in its user list.
+
+Pass --csv-file to change the roles of the users in a CSV file instead, which
+has a "user_id" or "email" column and optional "role_id" and "new_role_id"
+columns that default to the options. The result is reported per user, and the
+command exits with a non-zero status if any user fails.`,
+	Args: roleChangeCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		users, err := readUsers(roleChangeCfg.GetString("csv-file"), args, userRow{
+			RoleID:    roleChangeCfg.GetInt("role-id"),
+			NewRoleID: roleChangeCfg.GetInt("new-role-id"),
+		})
+		cliutil.HandleError(err, "error reading users")
+		cliutil.HandleError(checkUserRoles(users), "error reading users")
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		results := applyUsers(ctx, client, users, true, func(u *userRow) error {
+			_, err := client.ChangeUserRoleWithContext(ctx, &qb.ChangeUserRoleInput{
+				AppID:     globalCfg.AppID(),
+				UserID:    u.UserID,
+				RoleID:    u.RoleID,
+				NewRoleID: u.NewRoleID,
+			})
+			return err
+		})
+
+		renderUserResults(results)
+	},
+}
+
+func init() {
+	roleCmd.AddCommand(roleChangeCmd)
+	roleChangeCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(roleChangeCmd, roleChangeCfg)
+	flags.String("csv-file", "c", "", "path to a CSV file containing the users")
+	flags.Int("new-role-id", "n", 0, "ID of the role the users are moved to, defaults to none")
+	flags.Int("role-id", "r", 0, "ID of the users' current role")
+}
+
+func roleChangeCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if roleChangeCfg.GetInt("new-role-id") < 0 {
+		return errors.New("new-role-id option invalid: must not be negative")
+	}
+
+	if roleChangeCfg.GetString("csv-file") != "" {
+		if len(args) > 0 {
+			return errors.New("arguments cannot be used with the csv-file option")
+		}
+	} else if len(args) < 1 {
+		return errors.New("missing required argument: [USER]")
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"strconv"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var roleListCfg *viper.Viper
+
+var roleListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists an application's roles",
+	Long: `Lists the roles defined in the application passed via --app-id along with
+their level of access.`,
+	Args: roleListCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.GetRoleInfoInput{AppID: globalCfg.AppID()}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.GetRoleInfoWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, RoleListOutput{Roles: output.Roles})
+	},
+}
+
+func init() {
+	roleCmd.AddCommand(roleListCmd)
+	roleListCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+}
+
+func roleListCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	return globalCfg.Validate()
+}
+
+// RoleListOutput models the output that lists roles.
+type RoleListOutput struct {
+	Roles []qb.Role `json:"roles"`
+}
+
+// Table implements cliutil.Tabular and renders a row per role.
+func (out RoleListOutput) Table() (header []string, rows [][]string) {
+	header = []string{"ID", "Name", "Access"}
+	rows = make([][]string, len(out.Roles))
+	for k, r := range out.Roles {
+		rows[k] = []string{strconv.Itoa(r.RoleID), r.Name, r.Access.Name}
+	}
+	return
+}
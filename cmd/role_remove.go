@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var roleRemoveCfg *viper.Viper
+
+var roleRemoveCmd = &cobra.Command{
+	Use:   "remove [USER...]",
+	Short: "Removes users from a role",
+	Long: `Removes users from the role passed via --role-id in the application passed via
+--app-id. Users are identified by their user ID or email address. Users without
+any other role lose access to the application, but they still appear in the
+application's user list with no role. Use "role change" without --new-role-id
+to remove their access instead.
+
+Pass --csv-file to remove the users in a CSV file instead, which has a
+"user_id" or "email" column and an optional "role_id" column that defaults to
+--role-id. The result is reported per user, and the command exits with a
+non-zero status if any user fails.`,
+	Args: roleRemoveCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		users, err := readUsers(roleRemoveCfg.GetString("csv-file"), args, userRow{
+			RoleID: roleRemoveCfg.GetInt("role-id"),
+		})
+		cliutil.HandleError(err, "error reading users")
+		cliutil.HandleError(checkUserRoles(users), "error reading users")
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		results := applyUsers(ctx, client, users, true, func(u *userRow) error {
+			_, err := client.RemoveUserFromRoleWithContext(ctx, &qb.RemoveUserFromRoleInput{
+				AppID:  globalCfg.AppID(),
+				UserID: u.UserID,
+				RoleID: u.RoleID,
+			})
+			return err
+		})
+
+		renderUserResults(results)
+	},
+}
+
+func init() {
+	roleCmd.AddCommand(roleRemoveCmd)
+	roleRemoveCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(roleRemoveCmd, roleRemoveCfg)
+	flags.String("csv-file", "c", "", "path to a CSV file containing the users")
+	flags.Int("role-id", "r", 0, "ID of the role the users are removed from")
+}
+
+func roleRemoveCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if roleRemoveCfg.GetString("csv-file") != "" {
+		if len(args) > 0 {
+			return errors.New("arguments cannot be used with the csv-file option")
+		}
+	} else if len(args) < 1 {
+		return errors.New("missing required argument: [USER]")
+	}
+
+	return nil
+}
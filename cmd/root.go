@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -12,6 +13,11 @@ import (
 
 var globalCfg qbutil.GlobalConfig
 
+// rateLimiter limits the rate of requests sent by the clients returned by
+// newClient. It is nil if no rate limits are configured and the
+// --rate-limit-stats option isn't passed.
+var rateLimiter *qb.RateLimiter
+
 var rootCmd = &cobra.Command{
 	Use:   "quickbase-do-query",
 	Short: "A command line tool that gets records from a Quick Base table.",
@@ -19,6 +25,11 @@ var rootCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		cmd.Help()
 	},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		if globalCfg.RateLimitStats() && rateLimiter != nil {
+			writeRateLimitStats(rateLimiter.Stats(globalCfg.RealmHost()))
+		}
+	},
 }
 
 // Execute runs the root command.
@@ -33,3 +44,72 @@ func init() {
 	cfg := cliutil.InitConfig(qb.EnvVarPrefix)
 	globalCfg = qbutil.NewGlobalConfig(rootCmd, cfg)
 }
+
+// render writes v to STDOUT according to the global rendering options, e.g.
+// the JMESPath filter passed via the --filter option.
+func render(v interface{}) {
+	err := cliutil.Render(v, globalCfg.RenderOptions())
+	cliutil.HandleError(err, "error rendering output")
+}
+
+// renderResponse is like render, but writes the raw body of the API response
+// in place of v when the --raw option is passed. It is used by commands that
+// reshape the API response before rendering it.
+func renderResponse(res cliutil.RawResponder, v interface{}) {
+	if globalCfg.Raw() {
+		render(res)
+	} else {
+		render(v)
+	}
+}
+
+// newClient returns a qb.Client that retries failed requests according to the
+// --retries and --retry-writes options, and that limits the rate of requests
+// according to the rate limits in the config file. All clients share the same
+// rate limiter.
+func newClient() qb.Client {
+	client := qb.NewClient(globalCfg)
+	client.Retry = globalCfg.RetryPolicy()
+
+	// Rate limits are validated along with the global config.
+	if rateLimiter == nil {
+		if limits, _ := globalCfg.RateLimits(); len(limits) > 0 || globalCfg.RateLimitStats() {
+			rateLimiter = qb.NewRateLimiter(limits...)
+		}
+	}
+	if rateLimiter != nil {
+		client.Plugins = append(client.Plugins, rateLimiter)
+	}
+
+	return client
+}
+
+// rateLimitStatsResponse models the rate limiting metrics written to STDERR
+// when the --rate-limit-stats option is passed.
+type rateLimitStatsResponse struct {
+	Requests int64  `json:"requests"`
+	Delayed  int64  `json:"delayed"`
+	WaitTime string `json:"wait_time"`
+}
+
+// writeRateLimitStats writes the rate limiting metrics in JSON format to
+// STDERR so that they don't interfere with the rendered output.
+func writeRateLimitStats(stats qb.RateLimitStats) {
+	resp := rateLimitStatsResponse{
+		Requests: stats.Requests,
+		Delayed:  stats.Delayed,
+		WaitTime: stats.WaitTime.String(),
+	}
+	fmt.Fprintf(os.Stderr, "%s\n", cliutil.FormatJSON(resp))
+}
+
+// newContext returns a context that is canceled when a SIGINT or SIGTERM
+// signal is received or the duration passed via the --timeout option elapses.
+func newContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := cliutil.SignalContext(context.Background())
+	if timeout := globalCfg.Timeout(); timeout > 0 {
+		tctx, tcancel := context.WithTimeout(ctx, timeout)
+		return tctx, func() { tcancel(); cancel() }
+	}
+	return ctx, cancel
+}
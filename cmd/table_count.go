@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var tableCountCfg *viper.Viper
+
+var tableCountCmd = &cobra.Command{
+	Use:   "count",
+	Short: "Returns the number of records in a table",
+	Long: `Returns the total number of records in a table. Use "query --count" to count
+the records matched by a query.`,
+	Args: tableCountCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.GetNumRecordsInput{TableID: globalCfg.TableID()}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		output, err := client.GetNumRecordsWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		render(output)
+	},
+}
+
+func init() {
+	tableCmd.AddCommand(tableCountCmd)
+	tableCountCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+}
+
+func tableCountCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireTableID = true
+	return globalCfg.Validate()
+}
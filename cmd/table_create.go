@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var tableCreateCfg *viper.Viper
+
+var tableCreateCmd = &cobra.Command{
+	Use:   "create [NAME]",
+	Short: "Creates a table",
+	Long: `Creates a table in the application passed via --app-id and prints its dbid,
+which can be passed to --table-id, e.g. to add fields with "field add".`,
+	Args: tableCreateCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.CreateTableInput{
+			AppID:      globalCfg.AppID(),
+			Name:       args[0],
+			PluralNoun: tableCreateCfg.GetString("plural-noun"),
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.CreateTableWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		render(output)
+	},
+}
+
+func init() {
+	tableCmd.AddCommand(tableCreateCmd)
+	tableCreateCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(tableCreateCmd, tableCreateCfg)
+	flags.String("plural-noun", "p", "", "plural noun used to describe the table's records, e.g. Tasks")
+}
+
+func tableCreateCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if len(args) < 1 {
+		return errors.New("missing required argument: [NAME]")
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var tableDescribeCfg *viper.Viper
+
+var tableDescribeCmd = &cobra.Command{
+	Use:   "describe",
+	Short: "Describes a table's schema",
+	Long: `Describes a table's schema, including its fields and their properties, key
+field, reports, variables, and child tables.
+
+Use --output=table for a summary of the table's properties, or "field list
+--detailed" for a row per field.`,
+	Args: tableDescribeCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.GetSchemaInput{ID: globalCfg.TableID()}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		output, err := client.GetSchemaWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, TableDescribeOutput{output})
+	},
+}
+
+func init() {
+	tableCmd.AddCommand(tableDescribeCmd)
+	tableDescribeCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+}
+
+func tableDescribeCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireTableID = true
+	return globalCfg.Validate()
+}
+
+// TableDescribeOutput models the output that describes a table's schema.
+type TableDescribeOutput struct {
+	qb.GetSchemaOutput
+}
+
+// Table implements cliutil.Tabular and renders a row per table property.
+// Fields are summarized by ID and label.
+func (out TableDescribeOutput) Table() (header []string, rows [][]string) {
+	var required, unique, relationships, reports, variables, children []string
+	for _, f := range out.Fields {
+		if f.Required {
+			required = append(required, fieldName(f))
+		}
+		if f.Unique {
+			unique = append(unique, fieldName(f))
+		}
+		// Lookup and summary fields also reference the master table, but
+		// only reference fields define the relationship.
+		if f.MasterTableID != "" && f.Mode == "" {
+			relationships = append(relationships, fieldName(f)+" -> "+f.MasterTableID)
+		}
+	}
+	for _, q := range out.Queries {
+		reports = append(reports, strconv.Itoa(q.QueryID)+": "+q.Name)
+	}
+	for _, v := range out.Variables {
+		variables = append(variables, v.Name+"="+v.Value)
+	}
+	for _, c := range out.ChildTables {
+		children = append(children, c.Name+"="+c.TableID)
+	}
+
+	key := ""
+	if f, ok := out.KeyField(); ok {
+		key = fieldName(f)
+	}
+
+	header = []string{"Property", "Value"}
+	rows = [][]string{
+		{"Name", out.Name},
+		{"Description", out.Description},
+		{"Table ID", out.Original.TableID},
+		{"App ID", out.Original.AppID},
+		{"Key Field", key},
+		{"Fields", strconv.Itoa(len(out.Fields))},
+		{"Required Fields", strings.Join(required, ", ")},
+		{"Unique Fields", strings.Join(unique, ", ")},
+		{"Relationships", strings.Join(relationships, ", ")},
+		{"Reports", strings.Join(reports, ", ")},
+		{"Variables", strings.Join(variables, ", ")},
+		{"Child Tables", strings.Join(children, ", ")},
+	}
+	return
+}
+
+// fieldName returns the field's ID and label, e.g. "7: Name".
+func fieldName(f qb.GetSchemaOutputField) string {
+	return strconv.Itoa(f.FieldID) + ": " + f.Label
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var tableListCfg *viper.Viper
+
+var tableListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists an application's tables",
+	Long: `Lists the tables in the application passed via --app-id, including each
+table's dbid, which can be passed to --table-id, record count, and last-modified
+times.`,
+	Args: tableListCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.GetSchemaInput{ID: globalCfg.AppID()}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		output, err := client.GetSchemaWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		tables := make([]TableListOutputTable, len(output.ChildTables))
+		for k, t := range output.ChildTables {
+			info, err := client.GetDBInfoWithContext(ctx, &qb.GetDBInfoInput{ID: t.TableID})
+			cliutil.HandleError(err, "error executing request")
+
+			tables[k] = TableListOutputTable{
+				Name:                   info.Name,
+				TableID:                t.TableID,
+				Alias:                  t.Name,
+				NumRecords:             info.NumRecords,
+				LastModifiedTime:       formatTimestamp(info.LastModifiedTime),
+				LastRecordModifiedTime: formatTimestamp(info.LastRecordModifiedTime),
+			}
+		}
+
+		renderResponse(output, TableListOutput{Tables: tables})
+	},
+}
+
+func init() {
+	tableCmd.AddCommand(tableListCmd)
+	tableListCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+}
+
+func tableListCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	return globalCfg.Validate()
+}
+
+// TableListOutput models the output that lists an application's tables.
+type TableListOutput struct {
+	Tables []TableListOutputTable `json:"tables"`
+}
+
+// TableListOutputTable models a table in TableListOutput.
+type TableListOutputTable struct {
+	Name                   string `json:"name"`
+	TableID                string `json:"table_id"`
+	Alias                  string `json:"alias"`
+	NumRecords             int    `json:"num_records"`
+	LastModifiedTime       string `json:"last_modified_time,omitempty"`
+	LastRecordModifiedTime string `json:"last_record_modified_time,omitempty"`
+}
@@ -0,0 +1,245 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+)
+
+var userCmd = &cobra.Command{
+	Use:   "user",
+	Short: "Commands that manage users",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(userCmd)
+}
+
+// userRow is a user passed as an argument or read from the CSV file passed via
+// --csv-file, along with the values the command applies to the user.
+type userRow struct {
+	line      int
+	UserID    string
+	Email     string
+	FirstName string
+	LastName  string
+	RoleID    int
+	NewRoleID int
+}
+
+// newUserRows returns a userRow for each argument, which is either a user ID
+// or an email address. The remaining values are copied from defaults.
+func newUserRows(args []string, defaults userRow) []userRow {
+	users := make([]userRow, len(args))
+	for k, arg := range args {
+		users[k] = defaults
+		if strings.Contains(arg, "@") {
+			users[k].Email = arg
+		} else {
+			users[k].UserID = arg
+		}
+	}
+	return users
+}
+
+// readUserCSV returns the users in a CSV file with a header row. The file has
+// a "user_id" or "email" column and optional "first_name", "last_name",
+// "role_id", and "new_role_id" columns. Empty values are copied from defaults,
+// so the command's options apply to rows that don't set them.
+func readUserCSV(path string, defaults userRow) ([]userRow, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("error reading header: %s", err)
+	}
+
+	cols := map[string]int{"user_id": -1, "email": -1, "first_name": -1, "last_name": -1, "role_id": -1, "new_role_id": -1}
+	for k, name := range header {
+		if _, ok := cols[strings.TrimSpace(name)]; ok {
+			cols[strings.TrimSpace(name)] = k
+		}
+	}
+	if cols["user_id"] < 0 && cols["email"] < 0 {
+		return nil, errors.New("missing required column: user_id or email")
+	}
+
+	get := func(row []string, name string) string {
+		if cols[name] < 0 {
+			return ""
+		}
+		return strings.TrimSpace(row[cols[name]])
+	}
+
+	users := []userRow{}
+	for line := 2; ; line++ {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		u := defaults
+		u.line = line
+		u.UserID = get(row, "user_id")
+		u.Email = get(row, "email")
+		if u.UserID == "" && u.Email == "" {
+			return nil, fmt.Errorf("line %d: missing user_id or email", line)
+		}
+
+		if s := get(row, "first_name"); s != "" {
+			u.FirstName = s
+		}
+		if s := get(row, "last_name"); s != "" {
+			u.LastName = s
+		}
+		if s := get(row, "role_id"); s != "" {
+			if u.RoleID, err = strconv.Atoi(s); err != nil || u.RoleID <= 0 {
+				return nil, fmt.Errorf("line %d: invalid role_id", line)
+			}
+		}
+		if s := get(row, "new_role_id"); s != "" {
+			if u.NewRoleID, err = strconv.Atoi(s); err != nil || u.NewRoleID < 0 {
+				return nil, fmt.Errorf("line %d: invalid new_role_id", line)
+			}
+		}
+
+		users = append(users, u)
+	}
+
+	return users, nil
+}
+
+// readUsers returns the users passed as arguments, or the users in the CSV
+// file passed via --csv-file if path isn't empty.
+func readUsers(path string, args []string, defaults userRow) ([]userRow, error) {
+	if path != "" {
+		return readUserCSV(path, defaults)
+	}
+	return newUserRows(args, defaults), nil
+}
+
+// checkUserRoles returns an error identifying the first user without a role.
+func checkUserRoles(users []userRow) error {
+	for _, u := range users {
+		if u.RoleID > 0 {
+			continue
+		}
+		if u.line > 0 {
+			return fmt.Errorf("line %d: missing role_id, pass the role-id option or add a role_id column", u.line)
+		}
+		return errors.New("missing required option: role-id")
+	}
+	return nil
+}
+
+// applyUsers calls fn for each user and returns the result of each call. If
+// resolve is true, users identified by their email address are looked up via
+// API_GetUserInfo so that fn is passed their user ID.
+func applyUsers(ctx context.Context, client qb.Client, users []userRow, resolve bool, fn func(u *userRow) error) []UserResultOutput {
+	results := make([]UserResultOutput, len(users))
+	for k := range users {
+		u := &users[k]
+
+		var err error
+		if resolve && u.UserID == "" {
+			var output qb.GetUserInfoOutput
+			output, err = client.GetUserInfoWithContext(ctx, &qb.GetUserInfoInput{Email: u.Email})
+			u.UserID = output.User.UserID
+		}
+		if err == nil {
+			err = fn(u)
+		}
+
+		results[k] = newUserResultOutput(*u, err)
+	}
+	return results
+}
+
+// renderUserResults renders the result for each user and exits with a
+// non-zero status if the command failed for any user.
+func renderUserResults(results []UserResultOutput) {
+	if globalCfg.Batch() {
+		render(results)
+	} else {
+		render(UserResultListOutput{Users: results})
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		err := fmt.Errorf("%d of %d users failed", failed, len(results))
+		cliutil.HandleError(err, "error executing request")
+	}
+}
+
+// UserResultListOutput models the output printed after a command is applied
+// to users.
+type UserResultListOutput struct {
+	Users []UserResultOutput `json:"users"`
+}
+
+// Table implements cliutil.Tabular and renders a row per user.
+func (out UserResultListOutput) Table() (header []string, rows [][]string) {
+	header = []string{"User ID", "Email", "Role ID", "New Role ID", "Error"}
+	rows = make([][]string, len(out.Users))
+	for k, u := range out.Users {
+		rows[k] = []string{u.UserID, u.Email, formatRoleID(u.RoleID), formatRoleID(u.NewRoleID), u.Error}
+	}
+	return
+}
+
+// UserResultOutput models the result of applying a command to a user.
+type UserResultOutput struct {
+	UserID    string `json:"user_id,omitempty"`
+	Email     string `json:"email,omitempty"`
+	RoleID    int    `json:"role_id,omitempty"`
+	NewRoleID int    `json:"new_role_id,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// newUserResultOutput returns the result of applying a command to the user.
+func newUserResultOutput(u userRow, err error) UserResultOutput {
+	out := UserResultOutput{
+		UserID:    u.UserID,
+		Email:     u.Email,
+		RoleID:    u.RoleID,
+		NewRoleID: u.NewRoleID,
+	}
+	if err != nil {
+		out.Error = err.Error()
+	}
+	return out
+}
+
+// formatRoleID returns the role ID as a string, or an empty string if it is
+// zero.
+func formatRoleID(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
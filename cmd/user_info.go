@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var userInfoCfg *viper.Viper
+
+var userInfoCmd = &cobra.Command{
+	Use:   "info [EMAIL]",
+	Short: "Gets information about a user",
+	Long: `Gets information about the user with the email address or screen name passed
+as an argument, including their user ID. Information about the current user is
+returned if no argument is passed.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.GetUserInfoInput{}
+		if len(args) > 0 {
+			input.Email = args[0]
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.GetUserInfoWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, output.User)
+	},
+}
+
+func init() {
+	userCmd.AddCommand(userInfoCmd)
+	userInfoCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+}
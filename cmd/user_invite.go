@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var userInviteCfg *viper.Viper
+
+var userInviteCmd = &cobra.Command{
+	Use:   "invite [USER...]",
+	Short: "Invites users to an application",
+	Long: `Sends users an invitation to the application passed via --app-id. Users are
+identified by their user ID or email address, and they must already have a
+role in the application, e.g. after running "user provision".
+
+Pass --csv-file to invite the users in a CSV file instead, which has a "user_id"
+or "email" column. The result is reported per user, and the command exits with
+a non-zero status if any user fails.`,
+	Args: userInviteCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		users, err := readUsers(userInviteCfg.GetString("csv-file"), args, userRow{})
+		cliutil.HandleError(err, "error reading users")
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		results := applyUsers(ctx, client, users, true, func(u *userRow) error {
+			_, err := client.SendInvitationWithContext(ctx, &qb.SendInvitationInput{
+				AppID:    globalCfg.AppID(),
+				UserID:   u.UserID,
+				UserText: userInviteCfg.GetString("message"),
+			})
+			return err
+		})
+
+		renderUserResults(results)
+	},
+}
+
+func init() {
+	userCmd.AddCommand(userInviteCmd)
+	userInviteCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(userInviteCmd, userInviteCfg)
+	flags.String("csv-file", "c", "", "path to a CSV file containing the users")
+	flags.String("message", "m", "", "message included in the invitation")
+}
+
+func userInviteCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if userInviteCfg.GetString("csv-file") != "" {
+		if len(args) > 0 {
+			return errors.New("arguments cannot be used with the csv-file option")
+		}
+	} else if len(args) < 1 {
+		return errors.New("missing required argument: [USER]")
+	}
+
+	return nil
+}
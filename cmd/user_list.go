@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var userListCfg *viper.Viper
+
+var userListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists an application's users",
+	Long: `Lists the users and groups that have a role in the application passed via
+--app-id along with their roles.`,
+	Args: userListCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.UserRolesInput{AppID: globalCfg.AppID()}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.UserRolesWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, UserListOutput{Users: output.Users})
+	},
+}
+
+func init() {
+	userCmd.AddCommand(userListCmd)
+	userListCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+}
+
+func userListCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	return globalCfg.Validate()
+}
+
+// UserListOutput models the output that lists an application's users.
+type UserListOutput struct {
+	Users []qb.UserRolesOutputUser `json:"users"`
+}
+
+// Table implements cliutil.Tabular and renders a row per user.
+func (out UserListOutput) Table() (header []string, rows [][]string) {
+	header = []string{"User ID", "Type", "Name", "Roles", "Last Access"}
+	rows = make([][]string, len(out.Users))
+	for k, u := range out.Users {
+		rows[k] = []string{u.UserID, u.Type, u.Name, roleNames(u.Roles), formatTimestamp(u.LastAccess)}
+	}
+	return
+}
+
+// roleNames returns the names of the roles as a comma-separated list.
+func roleNames(roles []qb.Role) string {
+	names := make([]string, len(roles))
+	for k, r := range roles {
+		names[k] = r.Name
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var userProvisionCfg *viper.Viper
+
+var userProvisionCmd = &cobra.Command{
+	Use:   "provision [EMAIL...]",
+	Short: "Adds users to an application",
+	Long: `Adds users who aren't registered with Quick Base to the application passed via
+--app-id with the role passed via --role-id. Pass --invite to send each user an
+invitation, which they must accept before they can access the application.
+
+Pass --csv-file to provision the users in a CSV file instead, which has an
+"email" column and optional "first_name", "last_name", and "role_id" columns.
+The options are used for rows that don't set a value. The result is reported
+per user, and the command exits with a non-zero status if any user fails.`,
+	Args: userProvisionCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		users, err := readUsers(userProvisionCfg.GetString("csv-file"), args, userRow{
+			FirstName: userProvisionCfg.GetString("first-name"),
+			LastName:  userProvisionCfg.GetString("last-name"),
+			RoleID:    userProvisionCfg.GetInt("role-id"),
+		})
+		cliutil.HandleError(err, "error reading users")
+
+		for _, u := range users {
+			if u.Email == "" {
+				cliutil.HandleError(errors.New("users must be identified by email address"), "error reading users")
+			}
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		invite := userProvisionCfg.GetBool("invite")
+		results := applyUsers(ctx, client, users, false, func(u *userRow) error {
+			output, err := client.ProvisionUserWithContext(ctx, &qb.ProvisionUserInput{
+				AppID:     globalCfg.AppID(),
+				Email:     u.Email,
+				FirstName: u.FirstName,
+				LastName:  u.LastName,
+				RoleID:    u.RoleID,
+			})
+			if err != nil {
+				return err
+			}
+
+			u.UserID = output.UserID
+			if invite {
+				_, err = client.SendInvitationWithContext(ctx, &qb.SendInvitationInput{
+					AppID:    globalCfg.AppID(),
+					UserID:   u.UserID,
+					UserText: userProvisionCfg.GetString("message"),
+				})
+			}
+			return err
+		})
+
+		renderUserResults(results)
+	},
+}
+
+func init() {
+	userCmd.AddCommand(userProvisionCmd)
+	userProvisionCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(userProvisionCmd, userProvisionCfg)
+	flags.String("csv-file", "c", "", "path to a CSV file containing the users")
+	flags.String("first-name", "f", "", "the user's first name")
+	flags.Bool("invite", "i", false, "send the users an invitation")
+	flags.String("last-name", "l", "", "the user's last name")
+	flags.String("message", "m", "", "message included in the invitation")
+	flags.Int("role-id", "r", 0, "ID of the role the users are added to")
+}
+
+func userProvisionCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if userProvisionCfg.GetString("csv-file") != "" {
+		if len(args) > 0 {
+			return errors.New("arguments cannot be used with the csv-file option")
+		}
+	} else if len(args) < 1 {
+		return errors.New("missing required argument: [EMAIL]")
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var userRolesCfg *viper.Viper
+
+var userRolesCmd = &cobra.Command{
+	Use:   "roles [USER]",
+	Short: "Lists a user's roles",
+	Long: `Lists the roles a user has in the application passed via --app-id. The user
+is identified by their user ID or email address. Pass --include-groups to also
+list the roles the user has through the groups they belong to.`,
+	Args: userRolesCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.GetUserRoleInput{
+			AppID:         globalCfg.AppID(),
+			UserID:        args[0],
+			IncludeGroups: qb.Bool(userRolesCfg.GetBool("include-groups")),
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+
+		if strings.Contains(input.UserID, "@") {
+			info, err := client.GetUserInfoWithContext(ctx, &qb.GetUserInfoInput{Email: input.UserID})
+			cliutil.HandleError(err, "error executing request")
+			input.UserID = info.User.UserID
+		}
+
+		output, err := client.GetUserRoleWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, UserRolesOutput{output.User})
+	},
+}
+
+func init() {
+	userCmd.AddCommand(userRolesCmd)
+	userRolesCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+
+	flags := cliutil.NewFlagger(userRolesCmd, userRolesCfg)
+	flags.Bool("include-groups", "g", false, "include the roles the user has through groups")
+}
+
+func userRolesCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if len(args) < 1 {
+		return errors.New("missing required argument: [USER]")
+	}
+
+	return nil
+}
+
+// UserRolesOutput models the output that lists a user's roles.
+type UserRolesOutput struct {
+	qb.UserRolesOutputUser
+}
+
+// Table implements cliutil.Tabular and renders a row per role.
+func (out UserRolesOutput) Table() (header []string, rows [][]string) {
+	return RoleListOutput{Roles: out.Roles}.Table()
+}
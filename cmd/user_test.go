@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestNewUserRows(t *testing.T) {
+	got := newUserRows([]string{"112149.bhsv", "jack@example.com"}, userRow{RoleID: 11})
+	want := []userRow{
+		{UserID: "112149.bhsv", RoleID: 11},
+		{Email: "jack@example.com", RoleID: 11},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestReadUserCSV(t *testing.T) {
+	tests := []struct {
+		name     string
+		csv      string
+		defaults userRow
+		users    []userRow
+		err      bool
+	}{
+		{
+			name: "all columns",
+			csv:  "user_id,email,first_name,last_name,role_id,new_role_id\n112149.bhsv,,Jack,D,11,12\n,jill@example.com,Jill,,10,\n",
+			users: []userRow{
+				{line: 2, UserID: "112149.bhsv", FirstName: "Jack", LastName: "D", RoleID: 11, NewRoleID: 12},
+				{line: 3, Email: "jill@example.com", FirstName: "Jill", RoleID: 10},
+			},
+		},
+		{
+			name:     "defaults apply to empty values",
+			csv:      " email , role_id\njack@example.com,\njill@example.com,12\n",
+			defaults: userRow{RoleID: 11, FirstName: "New"},
+			users: []userRow{
+				{line: 2, Email: "jack@example.com", FirstName: "New", RoleID: 11},
+				{line: 3, Email: "jill@example.com", FirstName: "New", RoleID: 12},
+			},
+		},
+		{
+			name: "missing user column",
+			csv:  "first_name,role_id\nJack,11\n",
+			err:  true,
+		},
+		{
+			name: "missing user",
+			csv:  "user_id,email\n,\n",
+			err:  true,
+		},
+		{
+			name: "invalid role ID",
+			csv:  "user_id,role_id\n112149.bhsv,admin\n",
+			err:  true,
+		},
+		{
+			name: "invalid new role ID",
+			csv:  "user_id,new_role_id\n112149.bhsv,-1\n",
+			err:  true,
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "user-csv-")
+	if err != nil {
+		t.Fatalf("error creating directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "users.csv")
+
+	for _, tt := range tests {
+		if err := ioutil.WriteFile(path, []byte(tt.csv), 0644); err != nil {
+			t.Fatalf("error writing file: %s", err)
+		}
+
+		users, err := readUserCSV(path, tt.defaults)
+		if (err != nil) != tt.err {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+		} else if !tt.err && !reflect.DeepEqual(users, tt.users) {
+			t.Errorf("%s: expected %+v, got %+v", tt.name, tt.users, users)
+		}
+	}
+}
+
+func TestCheckUserRoles(t *testing.T) {
+	tests := []struct {
+		name  string
+		users []userRow
+		err   string
+	}{
+		{"all users have roles", []userRow{{UserID: "1", RoleID: 11}, {line: 3, UserID: "2", RoleID: 12}}, ""},
+		{"argument without role", []userRow{{UserID: "1"}}, "missing required option: role-id"},
+		{"row without role", []userRow{{line: 2, UserID: "1", RoleID: 11}, {line: 3, UserID: "2"}}, "line 3: missing role_id, pass the role-id option or add a role_id column"},
+	}
+
+	for _, tt := range tests {
+		err := checkUserRoles(tt.users)
+		if msg := errorString(err); msg != tt.err {
+			t.Errorf("%s: expected error %q, got %q", tt.name, tt.err, msg)
+		}
+	}
+}
+
+// errorString returns the message of err, or an empty string if err is nil.
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
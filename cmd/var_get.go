@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var varGetCfg *viper.Viper
+
+var varGetCmd = &cobra.Command{
+	Use:   "get [NAME]",
+	Short: "Gets a database variable",
+	Long: `Gets a database variable. Use --template="{{.value}}" to print only the
+value, e.g. in deploy scripts.`,
+	Args: varGetCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.GetVariableInput{
+			AppID: globalCfg.AppID(),
+			Name:  args[0],
+		}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.GetVariableWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		renderResponse(output, VarGetOutput{
+			Name:  args[0],
+			Value: output.Value,
+		})
+	},
+}
+
+func init() {
+	varCmd.AddCommand(varGetCmd)
+	varGetCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+}
+
+func varGetCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	if err := globalCfg.Validate(); err != nil {
+		return err
+	}
+
+	if len(args) < 1 {
+		return errors.New("missing required argument: [NAME]")
+	}
+
+	return nil
+}
+
+// VarGetOutput renders a database variable in JSON.
+type VarGetOutput struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"sort"
+
+	"github.com/cpliakas/quickbase-do-query/cliutil"
+	"github.com/cpliakas/quickbase-do-query/qb"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var varListCfg *viper.Viper
+
+var varListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists database variables",
+	Long: `Lists an application's database variables as a map of name to value, which
+is read from the application's schema.`,
+	Args: varListCmdValidate,
+	Run: func(cmd *cobra.Command, args []string) {
+		input := &qb.GetSchemaInput{ID: globalCfg.AppID()}
+
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.GetSchemaWithContext(ctx, input)
+		cliutil.HandleError(err, "error executing request")
+
+		vars := make(map[string]string, len(output.Variables))
+		for _, v := range output.Variables {
+			vars[v.Name] = v.Value
+		}
+
+		renderResponse(output, VarListOutput{Variables: vars})
+	},
+}
+
+func init() {
+	varCmd.AddCommand(varListCmd)
+	varListCfg = cliutil.InitConfig(qb.EnvVarPrefix)
+}
+
+func varListCmdValidate(cmd *cobra.Command, args []string) error {
+	globalCfg.RequireAppID = true
+	return globalCfg.Validate()
+}
+
+// VarListOutput renders database variables in JSON.
+type VarListOutput struct {
+	Variables map[string]string `json:"variables"`
+}
+
+// Table implements cliutil.Tabular and renders a row per variable.
+func (out VarListOutput) Table() (header []string, rows [][]string) {
+	names := make([]string, 0, len(out.Variables))
+	for name := range out.Variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	header = []string{"Name", "Value"}
+	rows = make([][]string, len(names))
+	for k, name := range names {
+		rows[k] = []string{name, out.Variables[name]}
+	}
+	return
+}
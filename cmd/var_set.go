@@ -24,11 +24,13 @@ var varSetCmd = &cobra.Command{
 			Value: args[1],
 		}
 
-		client := qb.NewClient(globalCfg)
-		output, err := client.SetVariable(input)
+		client := newClient()
+		ctx, cancel := newContext()
+		defer cancel()
+		output, err := client.SetVariableWithContext(ctx, input)
 		cliutil.HandleError(err, "error executing request")
 
-		cliutil.PrintJSON(VarSetOutput{
+		renderResponse(output, VarSetOutput{
 			UserData: output.UserData,
 			Name:     args[0],
 			Value:    args[1],
@@ -2,14 +2,69 @@ package qb
 
 import (
 	"bytes"
+	"context"
+	"encoding/base64"
 	"encoding/csv"
 	"encoding/xml"
-	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// AddFieldInput models the request sent to API_AddField
+// See https://help.quickbase.com/api-guide/add_field.html
+type AddFieldInput struct {
+	RequestParams
+	Credentials
+
+	TableID string `xml:"-"`
+	Label   string `xml:"label"`
+	Type    string `xml:"type"`
+	Mode    string `xml:"mode,omitempty"`
+}
+
+func (input *AddFieldInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *AddFieldInput) method() string                   { return http.MethodPost }
+func (input *AddFieldInput) uri() string                      { return "/db/" + input.TableID }
+func (input *AddFieldInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *AddFieldInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_AddField")
+}
+
+// AddFieldOutput models the response returned by API_AddField
+// See https://help.quickbase.com/api-guide/add_field.html
+type AddFieldOutput struct {
+	ResponseParams
+
+	FieldID int    `xml:"fid" json:"field_id"`
+	Label   string `xml:"label" json:"label"`
+}
+
+func (output *AddFieldOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// AddField makes an API_AddField call.
+// See https://help.quickbase.com/api-guide/add_field.html
+func (c Client) AddField(input *AddFieldInput) (AddFieldOutput, error) {
+	return c.AddFieldWithContext(context.Background(), input)
+}
+
+// AddFieldWithContext is the same as AddField with the addition of the
+// ability to pass a context.
+func (c Client) AddFieldWithContext(ctx context.Context, input *AddFieldInput) (output AddFieldOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_AddField", output.ResponseParams)
+	}
+	return
+}
+
 // AddRecordInput models the request sent to API_AddRecord.
 // See https://help.quickbase.com/api-guide/add_record.html
 type AddRecordInput struct {
@@ -57,10 +112,115 @@ func (output *AddRecordOutput) parse(body []byte, res *http.Response) error {
 // AddRecord makes an API_AddRecord call.
 // See https://help.quickbase.com/api-guide/index.html#add_record.html
 // MACHINE GENERATED BY GO GENERATE; DO NOT EDIT
-func (c Client) AddRecord(input *AddRecordInput) (output AddRecordOutput, err error) {
-	err = c.Do(input, &output)
+func (c Client) AddRecord(input *AddRecordInput) (AddRecordOutput, error) {
+	return c.AddRecordWithContext(context.Background(), input)
+}
+
+// AddRecordWithContext is the same as AddRecord with the addition of the
+// ability to pass a context.
+func (c Client) AddRecordWithContext(ctx context.Context, input *AddRecordInput) (output AddRecordOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_AddRecord", output.ResponseParams)
+	}
+	return
+}
+
+// AddReplaceDBPageInput models the request sent to API_AddReplaceDBPage
+// See https://help.quickbase.com/api-guide/addreplacedbpage.html
+type AddReplaceDBPageInput struct {
+	RequestParams
+	Credentials
+
+	AppID string `xml:"-"`
+
+	// PageID is the page being replaced. A page named PageName is created if
+	// PageID is zero.
+	PageID   int    `xml:"pageid,omitempty"`
+	PageName string `xml:"pagename,omitempty"`
+	PageType int    `xml:"pagetype"`
+	PageBody string `xml:"pagebody"`
+}
+
+func (input *AddReplaceDBPageInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *AddReplaceDBPageInput) method() string                   { return http.MethodPost }
+func (input *AddReplaceDBPageInput) uri() string                      { return "/db/" + input.AppID }
+func (input *AddReplaceDBPageInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *AddReplaceDBPageInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_AddReplaceDBPage")
+}
+
+// AddReplaceDBPageOutput models the response returned by API_AddReplaceDBPage
+// See https://help.quickbase.com/api-guide/addreplacedbpage.html
+type AddReplaceDBPageOutput struct {
+	ResponseParams
+
+	PageID int `xml:"pageID" json:"page_id"`
+}
+
+func (output *AddReplaceDBPageOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// AddReplaceDBPage makes an API_AddReplaceDBPage call.
+// See https://help.quickbase.com/api-guide/addreplacedbpage.html
+func (c Client) AddReplaceDBPage(input *AddReplaceDBPageInput) (AddReplaceDBPageOutput, error) {
+	return c.AddReplaceDBPageWithContext(context.Background(), input)
+}
+
+// AddReplaceDBPageWithContext is the same as AddReplaceDBPage with the
+// addition of the ability to pass a context.
+func (c Client) AddReplaceDBPageWithContext(ctx context.Context, input *AddReplaceDBPageInput) (output AddReplaceDBPageOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_AddReplaceDBPage", output.ResponseParams)
+	}
+	return
+}
+
+// AddUserToRoleInput models the request sent to API_AddUserToRole
+// See https://help.quickbase.com/api-guide/addusertorole.html
+type AddUserToRoleInput struct {
+	RequestParams
+	Credentials
+
+	AppID  string `xml:"-"`
+	UserID string `xml:"userid"`
+	RoleID int    `xml:"roleid"`
+}
+
+func (input *AddUserToRoleInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *AddUserToRoleInput) method() string                   { return http.MethodPost }
+func (input *AddUserToRoleInput) uri() string                      { return "/db/" + input.AppID }
+func (input *AddUserToRoleInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *AddUserToRoleInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_AddUserToRole")
+}
+
+// AddUserToRoleOutput models the response returned by API_AddUserToRole
+// See https://help.quickbase.com/api-guide/addusertorole.html
+type AddUserToRoleOutput struct {
+	ResponseParams
+}
+
+func (output *AddUserToRoleOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// AddUserToRole makes an API_AddUserToRole call.
+// See https://help.quickbase.com/api-guide/addusertorole.html
+func (c Client) AddUserToRole(input *AddUserToRoleInput) (AddUserToRoleOutput, error) {
+	return c.AddUserToRoleWithContext(context.Background(), input)
+}
+
+// AddUserToRoleWithContext is the same as AddUserToRole with the addition of
+// the ability to pass a context.
+func (c Client) AddUserToRoleWithContext(ctx context.Context, input *AddUserToRoleInput) (output AddUserToRoleOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
 	if err == nil && output.ErrorCode != 0 {
-		err = fmt.Errorf("error executing API_AddRecord: %s (error code: %v)", output.ErrorText, output.ErrorCode)
+		err = newAPIError("API_AddUserToRole", output.ResponseParams)
 	}
 	return
 }
@@ -90,6 +250,11 @@ type AuthenticateOutput struct {
 
 	Ticket string `xml:"ticket"`
 	UserID string `xml:"userid"`
+
+	// Username and Expires aren't returned by Quick Base, they are populated
+	// from the input so that they can be cached alongside the ticket.
+	Username string    `xml:"-"`
+	Expires  time.Time `xml:"-"`
 }
 
 func (output *AuthenticateOutput) parse(body []byte, res *http.Response) error {
@@ -98,364 +263,2137 @@ func (output *AuthenticateOutput) parse(body []byte, res *http.Response) error {
 
 // Authenticate makes call to API_Authenticate.
 // See https://help.quickbase.com/api-guide/authenticate.html
-func (c Client) Authenticate(input *AuthenticateInput) (output AuthenticateOutput, err error) {
-	err = c.Do(input, &output)
+func (c Client) Authenticate(input *AuthenticateInput) (AuthenticateOutput, error) {
+	return c.AuthenticateWithContext(context.Background(), input)
+}
+
+// AuthenticateWithContext is the same as Authenticate with the addition of the
+// ability to pass a context.
+func (c Client) AuthenticateWithContext(ctx context.Context, input *AuthenticateInput) (output AuthenticateOutput, err error) {
+	hours := input.Hours
+	if hours <= 0 {
+		hours = TicketHours
+	}
+	expires := time.Now().Add(time.Duration(hours) * time.Hour)
+
+	err = c.DoWithContext(ctx, input, &output)
 	if err == nil && output.ErrorCode != 0 {
-		err = fmt.Errorf("error executing API_Authenticate: %s (error code: %v)", output.ErrorText, output.ErrorCode)
+		err = newAPIError("API_Authenticate", output.ResponseParams)
 	}
+
+	output.Username = input.Username
+	output.Expires = expires
 	return
 }
 
-// DoQueryInput models the request sent to API_DoQuery.
-// See https://help.quickbase.com/api-guide/do_query.html
-type DoQueryInput struct {
+// ChangeRecordOwnerInput models the request sent to API_ChangeRecordOwner
+// See https://help.quickbase.com/api-guide/changerecordowner.html
+type ChangeRecordOwnerInput struct {
 	RequestParams
 	Credentials
 
-	TableID          string               `xml:"-"`
-	Query            string               `xml:"query,omitempty"`
-	QueryID          int                  `xml:"qid,omitempty"`
-	QueryName        string               `xml:"qname,omitempty"`
-	IncludeRecordIDs Bool                 `xml:"includeRids,omitempty"`
-	ReturnPercentage Bool                 `xml:"returnpercentage,omitempty"`
-	UseFieldIDs      Bool                 `xml:"useFids,omitempty"`
-	Format           string               `xml:"fmt,omitempty"`
-	FieldList        FieldList            `xml:"clist,omitempty"`
-	SortList         FieldList            `xml:"slist,omitempty"`
-	Options          *DoQueryInputOptions `xml:"options,omitempty"`
+	TableID  string `xml:"-"`
+	RecordID int    `xml:"rid"`
+
+	// NewOwner is the email address or screen name of the new owner.
+	NewOwner string `xml:"newowner"`
 }
 
-func (input *DoQueryInput) setCredentials(creds Credentials) { input.Credentials = creds }
-func (input *DoQueryInput) method() string                   { return http.MethodPost }
-func (input *DoQueryInput) uri() string                      { return "/db/" + input.TableID }
-func (input *DoQueryInput) payload() ([]byte, error)         { return xml.Marshal(input) }
-func (input *DoQueryInput) headers(req *http.Request) {
+func (input *ChangeRecordOwnerInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *ChangeRecordOwnerInput) method() string                   { return http.MethodPost }
+func (input *ChangeRecordOwnerInput) uri() string                      { return "/db/" + input.TableID }
+func (input *ChangeRecordOwnerInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *ChangeRecordOwnerInput) headers(req *http.Request) {
 	req.Header.Set("Content-Type", "application/xml")
-	req.Header.Set("QUICKBASE-ACTION", "API_DoQuery")
+	req.Header.Set("QUICKBASE-ACTION", "API_ChangeRecordOwner")
 }
 
-// EnsureOptions returns an initialized Options property. This method should be
-// used in favor of accessing the property directly to avoid null pointer
-// exceptions.
-func (input *DoQueryInput) EnsureOptions() *DoQueryInputOptions {
-	if input.Options == nil {
-		input.Options = &DoQueryInputOptions{}
+// ChangeRecordOwnerOutput models the response returned by API_ChangeRecordOwner
+// See https://help.quickbase.com/api-guide/changerecordowner.html
+type ChangeRecordOwnerOutput struct {
+	ResponseParams
+}
+
+func (output *ChangeRecordOwnerOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// ChangeRecordOwner makes an API_ChangeRecordOwner call.
+// See https://help.quickbase.com/api-guide/changerecordowner.html
+func (c Client) ChangeRecordOwner(input *ChangeRecordOwnerInput) (ChangeRecordOwnerOutput, error) {
+	return c.ChangeRecordOwnerWithContext(context.Background(), input)
+}
+
+// ChangeRecordOwnerWithContext is the same as ChangeRecordOwner with the
+// addition of the ability to pass a context.
+func (c Client) ChangeRecordOwnerWithContext(ctx context.Context, input *ChangeRecordOwnerInput) (output ChangeRecordOwnerOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_ChangeRecordOwner", output.ResponseParams)
 	}
-	return input.Options
+	return
 }
 
-// Fields sets the fields that are returned in the response.
-func (input *DoQueryInput) Fields(fids ...int) *DoQueryInput {
-	input.FieldList = fids
-	return input
+// ChangeUserRoleInput models the request sent to API_ChangeUserRole
+// See https://help.quickbase.com/api-guide/changeuserrole.html
+type ChangeUserRoleInput struct {
+	RequestParams
+	Credentials
+
+	AppID  string `xml:"-"`
+	UserID string `xml:"userid"`
+	RoleID int    `xml:"roleid"`
+
+	// NewRoleID is the role the user is moved to. The user's role is set to
+	// "None", which disables their access to the app, if NewRoleID is zero.
+	NewRoleID int `xml:"newroleid,omitempty"`
 }
 
-// SortBy sets the fields to be sorted by.
-func (input *DoQueryInput) SortBy(fids ...int) *DoQueryInput {
-	input.SortList = fids
-	return input
+func (input *ChangeUserRoleInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *ChangeUserRoleInput) method() string                   { return http.MethodPost }
+func (input *ChangeUserRoleInput) uri() string                      { return "/db/" + input.AppID }
+func (input *ChangeUserRoleInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *ChangeUserRoleInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_ChangeUserRole")
 }
 
-// SortOrder sets the "sortorder" option.
-func (input *DoQueryInput) SortOrder(order ...string) *DoQueryInput {
-	input.EnsureOptions().SortOrderList = order
-	return input
+// ChangeUserRoleOutput models the response returned by API_ChangeUserRole
+// See https://help.quickbase.com/api-guide/changeuserrole.html
+type ChangeUserRoleOutput struct {
+	ResponseParams
 }
 
-// Sort sets the fields and order in one shot.
-func (input *DoQueryInput) Sort(sort []int, order []string) *DoQueryInput {
-	input.SortList = sort
-	input.EnsureOptions().SortOrderList = order
-	return input
+func (output *ChangeUserRoleOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
 }
 
-// Limit sets the "num" option.
-func (input *DoQueryInput) Limit(n int) *DoQueryInput {
-	input.EnsureOptions().Limit = n
-	return input
+// ChangeUserRole makes an API_ChangeUserRole call.
+// See https://help.quickbase.com/api-guide/changeuserrole.html
+func (c Client) ChangeUserRole(input *ChangeUserRoleInput) (ChangeUserRoleOutput, error) {
+	return c.ChangeUserRoleWithContext(context.Background(), input)
 }
 
-// Offset sets the "skp" option.
-func (input *DoQueryInput) Offset(n int) *DoQueryInput {
-	input.EnsureOptions().Offset = n
-	return input
+// ChangeUserRoleWithContext is the same as ChangeUserRole with the addition
+// of the ability to pass a context.
+func (c Client) ChangeUserRoleWithContext(ctx context.Context, input *ChangeUserRoleInput) (output ChangeUserRoleOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_ChangeUserRole", output.ResponseParams)
+	}
+	return
 }
 
-// OnlyNew sets the "onlynew" option.
-func (input *DoQueryInput) OnlyNew() *DoQueryInput {
-	input.EnsureOptions().OnlyNew = true
-	return input
+// CloneDatabaseInput models the request sent to API_CloneDatabase
+// See https://help.quickbase.com/api-guide/clonedatabase.html
+type CloneDatabaseInput struct {
+	RequestParams
+	Credentials
+
+	AppID         string `xml:"-"`
+	Name          string `xml:"newdbname"`
+	Description   string `xml:"newdbdesc,omitempty"`
+	KeepData      Bool   `xml:"keepData,omitempty"`
+	ExcludeFiles  Bool   `xml:"excludefiles,omitempty"`
+	UsersAndRoles Bool   `xml:"usersandroles,omitempty"`
 }
 
-// Unsorted sets the "nosort" option.
-func (input *DoQueryInput) Unsorted() *DoQueryInput {
-	input.EnsureOptions().Unsorted = true
-	return input
+func (input *CloneDatabaseInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *CloneDatabaseInput) method() string                   { return http.MethodPost }
+func (input *CloneDatabaseInput) uri() string                      { return "/db/" + input.AppID }
+func (input *CloneDatabaseInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *CloneDatabaseInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_CloneDatabase")
 }
 
-// DoQueryInputOptions models the "options" element in API_DoQuery requests.
-type DoQueryInputOptions struct {
-	SortOrderList []string
-	Limit         int
-	Offset        int
-	OnlyNew       bool
-	Unsorted      bool
+// CloneDatabaseOutput models the response returned by API_CloneDatabase
+// See https://help.quickbase.com/api-guide/clonedatabase.html
+type CloneDatabaseOutput struct {
+	ResponseParams
+
+	AppID string `xml:"newdbid" json:"app_id"`
 }
 
-// MarshalXML implements Marshaler.MarshalXML and formats the value of the
-// "options" element.
-func (o DoQueryInputOptions) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	opts := []string{}
+func (output *CloneDatabaseOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
 
-	if o.Offset > 0 {
-		opts = append(opts, "skp-"+strconv.Itoa(o.Offset))
-	}
-	if o.Limit > 0 {
-		opts = append(opts, "num-"+strconv.Itoa(o.Limit))
-	}
-	if len(o.SortOrderList) > 0 {
-		opts = append(opts, "sortorder-"+strings.Join(o.SortOrderList, ""))
+// CloneDatabase makes an API_CloneDatabase call.
+// See https://help.quickbase.com/api-guide/clonedatabase.html
+func (c Client) CloneDatabase(input *CloneDatabaseInput) (CloneDatabaseOutput, error) {
+	return c.CloneDatabaseWithContext(context.Background(), input)
+}
+
+// CloneDatabaseWithContext is the same as CloneDatabase with the addition of
+// the ability to pass a context.
+func (c Client) CloneDatabaseWithContext(ctx context.Context, input *CloneDatabaseInput) (output CloneDatabaseOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_CloneDatabase", output.ResponseParams)
 	}
-	if o.OnlyNew == true {
-		opts = append(opts, "onlynew")
+	return
+}
+
+// CopyMasterDetailInput models the request sent to API_CopyMasterDetail
+// See https://help.quickbase.com/api-guide/copymasterdetail.html
+type CopyMasterDetailInput struct {
+	RequestParams
+	Credentials
+
+	// TableID is the master table.
+	TableID string `xml:"-"`
+
+	// DestRecordID is the master record the detail records are copied to. A
+	// copy of the source record is created if DestRecordID is zero.
+	DestRecordID   int `xml:"destrid"`
+	SourceRecordID int `xml:"sourcerid"`
+
+	// CopyFieldID is a text field whose value is prefixed with "Copy of" in the
+	// new master record. It is required if DestRecordID is zero.
+	CopyFieldID int `xml:"copyfid,omitempty"`
+
+	// RelationshipFieldIDs is a comma-separated list of the report link fields
+	// in the master table whose detail records are copied, or "all". Defaults
+	// to all relationships.
+	RelationshipFieldIDs string `xml:"relfids,omitempty"`
+}
+
+func (input *CopyMasterDetailInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *CopyMasterDetailInput) method() string                   { return http.MethodPost }
+func (input *CopyMasterDetailInput) uri() string                      { return "/db/" + input.TableID }
+func (input *CopyMasterDetailInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *CopyMasterDetailInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_CopyMasterDetail")
+}
+
+// CopyMasterDetailOutput models the response returned by API_CopyMasterDetail
+// See https://help.quickbase.com/api-guide/copymasterdetail.html
+type CopyMasterDetailOutput struct {
+	ResponseParams
+
+	ParentRecordID int `xml:"parentrid" json:"parent_record_id"`
+	NumCreated     int `xml:"numCreated" json:"num_created"`
+}
+
+func (output *CopyMasterDetailOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// CopyMasterDetail makes an API_CopyMasterDetail call.
+// See https://help.quickbase.com/api-guide/copymasterdetail.html
+func (c Client) CopyMasterDetail(input *CopyMasterDetailInput) (CopyMasterDetailOutput, error) {
+	return c.CopyMasterDetailWithContext(context.Background(), input)
+}
+
+// CopyMasterDetailWithContext is the same as CopyMasterDetail with the
+// addition of the ability to pass a context.
+func (c Client) CopyMasterDetailWithContext(ctx context.Context, input *CopyMasterDetailInput) (output CopyMasterDetailOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_CopyMasterDetail", output.ResponseParams)
 	}
-	if o.Unsorted == true {
-		opts = append(opts, "nosort")
+	return
+}
+
+// CreateDatabaseInput models the request sent to API_CreateDatabase
+// See https://help.quickbase.com/api-guide/createdatabase.html
+type CreateDatabaseInput struct {
+	RequestParams
+	Credentials
+
+	Name           string `xml:"dbname"`
+	Description    string `xml:"dbdesc,omitempty"`
+	CreateAppToken Bool   `xml:"createapptoken,omitempty"`
+}
+
+func (input *CreateDatabaseInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *CreateDatabaseInput) method() string                   { return http.MethodPost }
+func (input *CreateDatabaseInput) uri() string                      { return "/db/main" }
+func (input *CreateDatabaseInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *CreateDatabaseInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_CreateDatabase")
+}
+
+// CreateDatabaseOutput models the response returned by API_CreateDatabase
+// See https://help.quickbase.com/api-guide/createdatabase.html
+type CreateDatabaseOutput struct {
+	ResponseParams
+
+	AppID    string `xml:"appdbid" json:"app_id"`
+	AppToken string `xml:"apptoken" json:"app_token,omitempty"`
+}
+
+func (output *CreateDatabaseOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// CreateDatabase makes an API_CreateDatabase call.
+// See https://help.quickbase.com/api-guide/createdatabase.html
+func (c Client) CreateDatabase(input *CreateDatabaseInput) (CreateDatabaseOutput, error) {
+	return c.CreateDatabaseWithContext(context.Background(), input)
+}
+
+// CreateDatabaseWithContext is the same as CreateDatabase with the addition
+// of the ability to pass a context.
+func (c Client) CreateDatabaseWithContext(ctx context.Context, input *CreateDatabaseInput) (output CreateDatabaseOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_CreateDatabase", output.ResponseParams)
 	}
+	return
+}
 
-	return e.EncodeElement(strings.Join(opts, "."), start)
+// CreateTableInput models the request sent to API_CreateTable
+// See https://help.quickbase.com/api-guide/createtable.html
+type CreateTableInput struct {
+	RequestParams
+	Credentials
+
+	AppID      string `xml:"-"`
+	Name       string `xml:"tname,omitempty"`
+	PluralNoun string `xml:"pnoun,omitempty"`
+}
+
+func (input *CreateTableInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *CreateTableInput) method() string                   { return http.MethodPost }
+func (input *CreateTableInput) uri() string                      { return "/db/" + input.AppID }
+func (input *CreateTableInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *CreateTableInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_CreateTable")
+}
+
+// CreateTableOutput models the response returned by API_CreateTable
+// See https://help.quickbase.com/api-guide/createtable.html
+type CreateTableOutput struct {
+	ResponseParams
+
+	TableID string `xml:"newdbid" json:"table_id"`
+}
+
+func (output *CreateTableOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// CreateTable makes an API_CreateTable call.
+// See https://help.quickbase.com/api-guide/createtable.html
+func (c Client) CreateTable(input *CreateTableInput) (CreateTableOutput, error) {
+	return c.CreateTableWithContext(context.Background(), input)
+}
+
+// CreateTableWithContext is the same as CreateTable with the addition of the
+// ability to pass a context.
+func (c Client) CreateTableWithContext(ctx context.Context, input *CreateTableInput) (output CreateTableOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_CreateTable", output.ResponseParams)
+	}
+	return
+}
+
+// DeleteDatabaseInput models the request sent to API_DeleteDatabase
+// See https://help.quickbase.com/api-guide/deletedatabase.html
+type DeleteDatabaseInput struct {
+	RequestParams
+	Credentials
+
+	ID string `xml:"-"`
+}
+
+func (input *DeleteDatabaseInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *DeleteDatabaseInput) method() string                   { return http.MethodPost }
+func (input *DeleteDatabaseInput) uri() string                      { return "/db/" + input.ID }
+func (input *DeleteDatabaseInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *DeleteDatabaseInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_DeleteDatabase")
+}
+
+// DeleteDatabaseOutput models the response returned by API_DeleteDatabase
+// See https://help.quickbase.com/api-guide/deletedatabase.html
+type DeleteDatabaseOutput struct {
+	ResponseParams
+}
+
+func (output *DeleteDatabaseOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// DeleteDatabase makes an API_DeleteDatabase call.
+// See https://help.quickbase.com/api-guide/deletedatabase.html
+func (c Client) DeleteDatabase(input *DeleteDatabaseInput) (DeleteDatabaseOutput, error) {
+	return c.DeleteDatabaseWithContext(context.Background(), input)
+}
+
+// DeleteDatabaseWithContext is the same as DeleteDatabase with the addition
+// of the ability to pass a context.
+func (c Client) DeleteDatabaseWithContext(ctx context.Context, input *DeleteDatabaseInput) (output DeleteDatabaseOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_DeleteDatabase", output.ResponseParams)
+	}
+	return
+}
+
+// DeleteFieldInput models the request sent to API_DeleteField
+// See https://help.quickbase.com/api-guide/delete_field.html
+type DeleteFieldInput struct {
+	RequestParams
+	Credentials
+
+	TableID string `xml:"-"`
+	FieldID int    `xml:"fid"`
+}
+
+func (input *DeleteFieldInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *DeleteFieldInput) method() string                   { return http.MethodPost }
+func (input *DeleteFieldInput) uri() string                      { return "/db/" + input.TableID }
+func (input *DeleteFieldInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *DeleteFieldInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_DeleteField")
+}
+
+// DeleteFieldOutput models the response returned by API_DeleteField
+// See https://help.quickbase.com/api-guide/delete_field.html
+type DeleteFieldOutput struct {
+	ResponseParams
+}
+
+func (output *DeleteFieldOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// DeleteField makes an API_DeleteField call.
+// See https://help.quickbase.com/api-guide/delete_field.html
+func (c Client) DeleteField(input *DeleteFieldInput) (DeleteFieldOutput, error) {
+	return c.DeleteFieldWithContext(context.Background(), input)
+}
+
+// DeleteFieldWithContext is the same as DeleteField with the addition of the
+// ability to pass a context.
+func (c Client) DeleteFieldWithContext(ctx context.Context, input *DeleteFieldInput) (output DeleteFieldOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_DeleteField", output.ResponseParams)
+	}
+	return
+}
+
+// DeleteRecordInput models the request sent to API_DeleteRecord
+// See https://help.quickbase.com/api-guide/delete_record.html
+type DeleteRecordInput struct {
+	RequestParams
+	Credentials
+
+	TableID  string `xml:"-"`
+	RecordID int    `xml:"rid"`
+}
+
+func (input *DeleteRecordInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *DeleteRecordInput) method() string                   { return http.MethodPost }
+func (input *DeleteRecordInput) uri() string                      { return "/db/" + input.TableID }
+func (input *DeleteRecordInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *DeleteRecordInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_DeleteRecord")
+}
+
+// DeleteRecordOutput models the response returned by API_DeleteRecord
+// See https://help.quickbase.com/api-guide/delete_record.html
+type DeleteRecordOutput struct {
+	ResponseParams
+
+	RecordID int `xml:"rid" json:"record_id"`
+}
+
+func (output *DeleteRecordOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// DeleteRecord makes an API_DeleteRecord call.
+// See https://help.quickbase.com/api-guide/delete_record.html
+func (c Client) DeleteRecord(input *DeleteRecordInput) (DeleteRecordOutput, error) {
+	return c.DeleteRecordWithContext(context.Background(), input)
+}
+
+// DeleteRecordWithContext is the same as DeleteRecord with the addition of
+// the ability to pass a context.
+func (c Client) DeleteRecordWithContext(ctx context.Context, input *DeleteRecordInput) (output DeleteRecordOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_DeleteRecord", output.ResponseParams)
+	}
+	return
+}
+
+// DoQueryInput models the request sent to API_DoQuery.
+// See https://help.quickbase.com/api-guide/do_query.html
+type DoQueryInput struct {
+	RequestParams
+	Credentials
+
+	TableID          string               `xml:"-"`
+	Query            string               `xml:"query,omitempty"`
+	QueryID          int                  `xml:"qid,omitempty"`
+	QueryName        string               `xml:"qname,omitempty"`
+	IncludeRecordIDs Bool                 `xml:"includeRids,omitempty"`
+	ReturnPercentage Bool                 `xml:"returnpercentage,omitempty"`
+	UseFieldIDs      Bool                 `xml:"useFids,omitempty"`
+	Format           string               `xml:"fmt,omitempty"`
+	FieldList        FieldList            `xml:"clist,omitempty"`
+	SortList         FieldList            `xml:"slist,omitempty"`
+	Options          *DoQueryInputOptions `xml:"options,omitempty"`
+}
+
+func (input *DoQueryInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *DoQueryInput) method() string                   { return http.MethodPost }
+func (input *DoQueryInput) uri() string                      { return "/db/" + input.TableID }
+func (input *DoQueryInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *DoQueryInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_DoQuery")
+}
+
+// EnsureOptions returns an initialized Options property. This method should be
+// used in favor of accessing the property directly to avoid null pointer
+// exceptions.
+func (input *DoQueryInput) EnsureOptions() *DoQueryInputOptions {
+	if input.Options == nil {
+		input.Options = &DoQueryInputOptions{}
+	}
+	return input.Options
+}
+
+// Fields sets the fields that are returned in the response.
+func (input *DoQueryInput) Fields(fids ...int) *DoQueryInput {
+	input.FieldList = fids
+	return input
+}
+
+// SortBy sets the fields to be sorted by.
+func (input *DoQueryInput) SortBy(fids ...int) *DoQueryInput {
+	input.SortList = fids
+	return input
+}
+
+// SortOrder sets the "sortorder" option.
+func (input *DoQueryInput) SortOrder(order ...string) *DoQueryInput {
+	input.EnsureOptions().SortOrderList = order
+	return input
+}
+
+// Sort sets the fields and order in one shot.
+func (input *DoQueryInput) Sort(sort []int, order []string) *DoQueryInput {
+	input.SortList = sort
+	input.EnsureOptions().SortOrderList = order
+	return input
+}
+
+// Limit sets the "num" option.
+func (input *DoQueryInput) Limit(n int) *DoQueryInput {
+	input.EnsureOptions().Limit = n
+	return input
+}
+
+// Offset sets the "skp" option.
+func (input *DoQueryInput) Offset(n int) *DoQueryInput {
+	input.EnsureOptions().Offset = n
+	return input
+}
+
+// OnlyNew sets the "onlynew" option.
+func (input *DoQueryInput) OnlyNew() *DoQueryInput {
+	input.EnsureOptions().OnlyNew = true
+	return input
+}
+
+// Unsorted sets the "nosort" option.
+func (input *DoQueryInput) Unsorted() *DoQueryInput {
+	input.EnsureOptions().Unsorted = true
+	return input
+}
+
+// DoQueryInputOptions models the "options" element in API_DoQuery requests.
+type DoQueryInputOptions struct {
+	SortOrderList []string
+	Limit         int
+	Offset        int
+	OnlyNew       bool
+	Unsorted      bool
+}
+
+// MarshalXML implements Marshaler.MarshalXML and formats the value of the
+// "options" element.
+func (o DoQueryInputOptions) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	opts := []string{}
+
+	if o.Offset > 0 {
+		opts = append(opts, "skp-"+strconv.Itoa(o.Offset))
+	}
+	if o.Limit > 0 {
+		opts = append(opts, "num-"+strconv.Itoa(o.Limit))
+	}
+	if len(o.SortOrderList) > 0 {
+		opts = append(opts, "sortorder-"+strings.Join(o.SortOrderList, ""))
+	}
+	if o.OnlyNew == true {
+		opts = append(opts, "onlynew")
+	}
+	if o.Unsorted == true {
+		opts = append(opts, "nosort")
+	}
+
+	return e.EncodeElement(strings.Join(opts, "."), start)
+}
+
+// DoQueryOutput models the response returned by API_DoQuery.
+// See https://help.quickbase.com/api-guide/do_query.html.
+type DoQueryOutput struct {
+	ResponseParams
+
+	Fields  []DoQueryOutputField  `xml:"table>fields>field"`
+	Records []DoQueryOutputRecord `xml:"table>records>record"`
+}
+
+// DoQueryOutputField models the "table>fields" element. in an API_DoQuery
+// response.
+type DoQueryOutputField struct {
+	FieldID  int    `xml:"id,attr"`
+	Type     string `xml:"field_type,attr"`
+	BaseType string `xml:"base_type,attr"`
+	Mode     string `xml:"mode,attr"`
+	Label    string `xml:"label"`
+}
+
+// DoQueryOutputRecord models the "table>records>record" element in an
+// API_DoQuery response.
+type DoQueryOutputRecord struct {
+	RecordID int                        `xml:"rid,attr"`
+	UpdateID int                        `xml:"update_id"`
+	Fields   []DoQueryOutputRecordField `xml:"f"`
+}
+
+// DoQueryOutputRecordField models the "table>records>record>field" element
+// in an API_DoQuery response.
+type DoQueryOutputRecordField struct {
+	FieldID int    `xml:"id,attr"`
+	Value   string `xml:",chardata"`
+}
+
+func (output *DoQueryOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// DoQuery makes call to API_DoQuery.
+// See https://help.quickbase.com/api-guide/do_query.html.
+func (c Client) DoQuery(input *DoQueryInput) (DoQueryOutput, error) {
+	return c.DoQueryWithContext(context.Background(), input)
+}
+
+// DoQueryWithContext is the same as DoQuery with the addition of the
+// ability to pass a context.
+func (c Client) DoQueryWithContext(ctx context.Context, input *DoQueryInput) (output DoQueryOutput, err error) {
+	// Required for predictable output.
+	input.Format = "structured"
+	input.IncludeRecordIDs = true
+
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_DoQuery", output.ResponseParams)
+	}
+	return
+}
+
+// DoQueryPages iterates over the pages of records matched by an API_DoQuery
+// call, invoking fn with the output of each page. Iteration stops when fn
+// returns false or the last page is reached. The page size is the input's
+// "num" option, see DoQueryInput.Limit, or DefaultPageSize if it isn't set.
+// Iteration starts at the input's "skp" option. The input is not modified.
+//
+// Records should be sorted by a field with unique values, e.g. the Record ID#
+// field, so that records aren't skipped or repeated across pages if the table
+// is modified during iteration.
+func (c Client) DoQueryPages(input *DoQueryInput, fn func(output DoQueryOutput, lastPage bool) bool) error {
+	return c.DoQueryPagesWithContext(context.Background(), input, fn)
+}
+
+// DoQueryPagesWithContext is the same as DoQueryPages with the addition of
+// the ability to pass a context. Iteration stops if the context is canceled.
+func (c Client) DoQueryPagesWithContext(ctx context.Context, input *DoQueryInput, fn func(output DoQueryOutput, lastPage bool) bool) error {
+	in := *input
+	opts := DoQueryInputOptions{}
+	if input.Options != nil {
+		opts = *input.Options
+	}
+	in.Options = &opts
+
+	if opts.Limit <= 0 {
+		opts.Limit = DefaultPageSize
+	}
+
+	for page := 0; ; page++ {
+		output, err := c.DoQueryWithContext(ctx, &in)
+		if err != nil {
+			return err
+		}
+
+		// Don't invoke fn with an empty page unless it is the only one.
+		if page > 0 && len(output.Records) == 0 {
+			return nil
+		}
+
+		lastPage := len(output.Records) < opts.Limit
+		if !fn(output, lastPage) || lastPage {
+			return nil
+		}
+
+		opts.Offset += opts.Limit
+	}
+}
+
+// DoQueryCountInput models the request sent to API_DoQueryCount
+// See https://help.quickbase.com/api-guide/do_query_count.html
+type DoQueryCountInput struct {
+	RequestParams
+	Credentials
+
+	TableID   string `xml:"-"`
+	Query     string `xml:"query,omitempty"`
+	QueryID   int    `xml:"qid,omitempty"`
+	QueryName string `xml:"qname,omitempty"`
+}
+
+func (input *DoQueryCountInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *DoQueryCountInput) method() string                   { return http.MethodPost }
+func (input *DoQueryCountInput) uri() string                      { return "/db/" + input.TableID }
+func (input *DoQueryCountInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *DoQueryCountInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_DoQueryCount")
+}
+
+// DoQueryCountOutput models the response returned by API_DoQueryCount
+// See https://help.quickbase.com/api-guide/do_query_count.html
+type DoQueryCountOutput struct {
+	ResponseParams
+
+	NumMatches int `xml:"numMatches" json:"num_matches"`
+}
+
+func (output *DoQueryCountOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// DoQueryCount makes an API_DoQueryCount call.
+// See https://help.quickbase.com/api-guide/do_query_count.html
+func (c Client) DoQueryCount(input *DoQueryCountInput) (DoQueryCountOutput, error) {
+	return c.DoQueryCountWithContext(context.Background(), input)
+}
+
+// DoQueryCountWithContext is the same as DoQueryCount with the addition of
+// the ability to pass a context.
+func (c Client) DoQueryCountWithContext(ctx context.Context, input *DoQueryCountInput) (output DoQueryCountOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_DoQueryCount", output.ResponseParams)
+	}
+	return
+}
+
+// EditRecordInput models the request sent to API_EditRecord.
+// See https://help.quickbase.com/api-guide/edit_record.html
+type EditRecordInput struct {
+	RequestParams
+	Credentials
+
+	TableID           string                 `xml:"-"`
+	DisplayRecord     bool                   `xml:"disprec,int,omitempty"`
+	SetCheckboxFields bool                   `xml:"fform,int,omitempty"`
+	Fields            []EditRecordInputField `xml:"field"`
+	IgnoreError       bool                   `xml:"ignoreError,int,omitempty"`
+	MillisecondsInUtc bool                   `xml:"msInUTC,int,omitempty"`
+	RecordID          int                    `xml:"rid"`
+	UpdateID          int                    `xml:"update_id,omitempty"`
+}
+
+func (input *EditRecordInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *EditRecordInput) method() string                   { return http.MethodPost }
+func (input *EditRecordInput) uri() string                      { return "/db/" + input.TableID }
+func (input *EditRecordInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *EditRecordInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_EditRecord")
+}
+
+// EditRecordInputField models the "field" element in API_EditRecord requests.
+type EditRecordInputField struct {
+	ID       int    `xml:"fid,attr,omitempty"`
+	FileName string `xml:"filename,attr,omitempty"`
+	Label    string `xml:"name,attr,omitempty"`
+	Value    string `xml:",chardata"`
+}
+
+// EditRecordOutput models the response returned by API_EditRecord
+// See https://help.quickbase.com/api-guide/edit_record.html
+type EditRecordOutput struct {
+	ResponseParams
+
+	NumFieldsChanged int `xml:"num_fields_changed" json:"num_fields_changed"`
+	RecordID         int `xml:"rid" json:"record_id"`
+	UpdateID         int `xml:"update_id" json:"update_id"`
+}
+
+func (output *EditRecordOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// EditRecord makes an API_EditRecord call.
+// See https://help.quickbase.com/api-guide/edit_record.html
+func (c Client) EditRecord(input *EditRecordInput) (EditRecordOutput, error) {
+	return c.EditRecordWithContext(context.Background(), input)
+}
+
+// EditRecordWithContext is the same as EditRecord with the addition of the
+// ability to pass a context.
+func (c Client) EditRecordWithContext(ctx context.Context, input *EditRecordInput) (output EditRecordOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_EditRecord", output.ResponseParams)
+	}
+	return
+}
+
+// FieldAddChoicesInput models the request sent to API_FieldAddChoices
+// See https://help.quickbase.com/api-guide/field_add_choices.html
+type FieldAddChoicesInput struct {
+	RequestParams
+	Credentials
+
+	TableID string   `xml:"-"`
+	FieldID int      `xml:"fid"`
+	Choices []string `xml:"choice"`
+}
+
+func (input *FieldAddChoicesInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *FieldAddChoicesInput) method() string                   { return http.MethodPost }
+func (input *FieldAddChoicesInput) uri() string                      { return "/db/" + input.TableID }
+func (input *FieldAddChoicesInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *FieldAddChoicesInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_FieldAddChoices")
+}
+
+// FieldAddChoicesOutput models the response returned by API_FieldAddChoices
+// See https://help.quickbase.com/api-guide/field_add_choices.html
+type FieldAddChoicesOutput struct {
+	ResponseParams
+
+	FieldID   int    `xml:"fid" json:"field_id"`
+	FieldName string `xml:"fname" json:"field_name"`
+	NumAdded  int    `xml:"numadded" json:"num_added"`
+}
+
+func (output *FieldAddChoicesOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// FieldAddChoices makes an API_FieldAddChoices call.
+// See https://help.quickbase.com/api-guide/field_add_choices.html
+func (c Client) FieldAddChoices(input *FieldAddChoicesInput) (FieldAddChoicesOutput, error) {
+	return c.FieldAddChoicesWithContext(context.Background(), input)
+}
+
+// FieldAddChoicesWithContext is the same as FieldAddChoices with the addition of
+// the ability to pass a context.
+func (c Client) FieldAddChoicesWithContext(ctx context.Context, input *FieldAddChoicesInput) (output FieldAddChoicesOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_FieldAddChoices", output.ResponseParams)
+	}
+	return
+}
+
+// FieldRemoveChoicesInput models the request sent to API_FieldRemoveChoices
+// See https://help.quickbase.com/api-guide/field_remove_choices.html
+type FieldRemoveChoicesInput struct {
+	RequestParams
+	Credentials
+
+	TableID string   `xml:"-"`
+	FieldID int      `xml:"fid"`
+	Choices []string `xml:"choice"`
+}
+
+func (input *FieldRemoveChoicesInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *FieldRemoveChoicesInput) method() string                   { return http.MethodPost }
+func (input *FieldRemoveChoicesInput) uri() string                      { return "/db/" + input.TableID }
+func (input *FieldRemoveChoicesInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *FieldRemoveChoicesInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_FieldRemoveChoices")
+}
+
+// FieldRemoveChoicesOutput models the response returned by API_FieldRemoveChoices
+// See https://help.quickbase.com/api-guide/field_remove_choices.html
+type FieldRemoveChoicesOutput struct {
+	ResponseParams
+
+	FieldID    int    `xml:"fid" json:"field_id"`
+	FieldName  string `xml:"fname" json:"field_name"`
+	NumRemoved int    `xml:"numremoved" json:"num_removed"`
+}
+
+func (output *FieldRemoveChoicesOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// FieldRemoveChoices makes an API_FieldRemoveChoices call.
+// See https://help.quickbase.com/api-guide/field_remove_choices.html
+func (c Client) FieldRemoveChoices(input *FieldRemoveChoicesInput) (FieldRemoveChoicesOutput, error) {
+	return c.FieldRemoveChoicesWithContext(context.Background(), input)
+}
+
+// FieldRemoveChoicesWithContext is the same as FieldRemoveChoices with the addition of
+// the ability to pass a context.
+func (c Client) FieldRemoveChoicesWithContext(ctx context.Context, input *FieldRemoveChoicesInput) (output FieldRemoveChoicesOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_FieldRemoveChoices", output.ResponseParams)
+	}
+	return
+}
+
+// FindDBByNameInput models the request sent to API_FindDBByName
+// See https://help.quickbase.com/api-guide/findDBbyname.html
+type FindDBByNameInput struct {
+	RequestParams
+	Credentials
+
+	Name        string `xml:"dbname"`
+	ParentsOnly Bool   `xml:"ParentsOnly,omitempty"`
+}
+
+func (input *FindDBByNameInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *FindDBByNameInput) method() string                   { return http.MethodPost }
+func (input *FindDBByNameInput) uri() string                      { return "/db/main" }
+func (input *FindDBByNameInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *FindDBByNameInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_FindDBByName")
+}
+
+// FindDBByNameOutput models the response returned by API_FindDBByName
+// See https://help.quickbase.com/api-guide/findDBbyname.html
+type FindDBByNameOutput struct {
+	ResponseParams
+
+	ID string `xml:"dbid" json:"id"`
+}
+
+func (output *FindDBByNameOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// FindDBByName makes an API_FindDBByName call.
+// See https://help.quickbase.com/api-guide/findDBbyname.html
+func (c Client) FindDBByName(input *FindDBByNameInput) (FindDBByNameOutput, error) {
+	return c.FindDBByNameWithContext(context.Background(), input)
+}
+
+// FindDBByNameWithContext is the same as FindDBByName with the addition of
+// the ability to pass a context.
+func (c Client) FindDBByNameWithContext(ctx context.Context, input *FindDBByNameInput) (output FindDBByNameOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_FindDBByName", output.ResponseParams)
+	}
+	return
+}
+
+// GetAncestorInfoInput models the request sent to API_GetAncestorInfo
+// See https://help.quickbase.com/api-guide/getancestorinfo.html
+type GetAncestorInfoInput struct {
+	RequestParams
+	Credentials
+
+	AppID string `xml:"-"`
+}
+
+func (input *GetAncestorInfoInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *GetAncestorInfoInput) method() string                   { return http.MethodPost }
+func (input *GetAncestorInfoInput) uri() string                      { return "/db/" + input.AppID }
+func (input *GetAncestorInfoInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *GetAncestorInfoInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_GetAncestorInfo")
+}
+
+// GetAncestorInfoOutput models the response returned by API_GetAncestorInfo
+// See https://help.quickbase.com/api-guide/getancestorinfo.html
+type GetAncestorInfoOutput struct {
+	ResponseParams
+
+	AncestorAppID       string `xml:"ancestorappid" json:"ancestor_app_id,omitempty"`
+	OldestAncestorAppID string `xml:"oldestancestorappid" json:"oldest_ancestor_app_id,omitempty"`
+}
+
+func (output *GetAncestorInfoOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// GetAncestorInfo makes an API_GetAncestorInfo call.
+// See https://help.quickbase.com/api-guide/getancestorinfo.html
+func (c Client) GetAncestorInfo(input *GetAncestorInfoInput) (GetAncestorInfoOutput, error) {
+	return c.GetAncestorInfoWithContext(context.Background(), input)
+}
+
+// GetAncestorInfoWithContext is the same as GetAncestorInfo with the
+// addition of the ability to pass a context.
+func (c Client) GetAncestorInfoWithContext(ctx context.Context, input *GetAncestorInfoInput) (output GetAncestorInfoOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_GetAncestorInfo", output.ResponseParams)
+	}
+	return
+}
+
+// GetDBInfoInput models the request sent to API_GetDBInfo
+// See https://help.quickbase.com/api-guide/getdbinfo.html
+type GetDBInfoInput struct {
+	RequestParams
+	Credentials
+
+	ID string `xml:"-"`
+}
+
+func (input *GetDBInfoInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *GetDBInfoInput) method() string                   { return http.MethodPost }
+func (input *GetDBInfoInput) uri() string                      { return "/db/" + input.ID }
+func (input *GetDBInfoInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *GetDBInfoInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_GetDBInfo")
+}
+
+// GetDBInfoOutput models the response returned by API_GetDBInfo
+// See https://help.quickbase.com/api-guide/getdbinfo.html
+type GetDBInfoOutput struct {
+	ResponseParams
+
+	Name                   string `xml:"dbname" json:"name"`
+	NumRecords             int    `xml:"numRecords" json:"num_records"`
+	CreatedTime            int64  `xml:"createdTime" json:"created_time"`
+	LastModifiedTime       int64  `xml:"lastModifiedTime" json:"last_modified_time"`
+	LastRecordModifiedTime int64  `xml:"lastRecModTime" json:"last_record_modified_time"`
+	ManagerID              string `xml:"mgrID" json:"manager_id,omitempty"`
+	ManagerName            string `xml:"mgrName" json:"manager_name,omitempty"`
+	Version                string `xml:"version" json:"version,omitempty"`
+	TimeZone               string `xml:"time_zone" json:"time_zone,omitempty"`
+}
+
+func (output *GetDBInfoOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// GetDBInfo makes an API_GetDBInfo call.
+// See https://help.quickbase.com/api-guide/getdbinfo.html
+func (c Client) GetDBInfo(input *GetDBInfoInput) (GetDBInfoOutput, error) {
+	return c.GetDBInfoWithContext(context.Background(), input)
+}
+
+// GetDBInfoWithContext is the same as GetDBInfo with the addition of the
+// ability to pass a context.
+func (c Client) GetDBInfoWithContext(ctx context.Context, input *GetDBInfoInput) (output GetDBInfoOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_GetDBInfo", output.ResponseParams)
+	}
+	return
+}
+
+// GetDBPageInput models the request sent to API_GetDBPage
+// See https://help.quickbase.com/api-guide/getdbpage.html
+type GetDBPageInput struct {
+	RequestParams
+	Credentials
+
+	AppID string `xml:"-"`
+
+	// PageID is the ID or name of the page.
+	PageID string `xml:"pageID"`
+}
+
+func (input *GetDBPageInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *GetDBPageInput) method() string                   { return http.MethodPost }
+func (input *GetDBPageInput) uri() string                      { return "/db/" + input.AppID }
+func (input *GetDBPageInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *GetDBPageInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_GetDBPage")
+}
+
+// GetDBPageOutput models the response returned by API_GetDBPage
+// See https://help.quickbase.com/api-guide/getdbpage.html
+type GetDBPageOutput struct {
+	ResponseParams
+
+	// Content is the body of the page.
+	Content string `xml:"-" json:"content"`
+}
+
+func (output *GetDBPageOutput) setHtml(b []byte) { output.Content = string(b) }
+
+func (output *GetDBPageOutput) parse(body []byte, res *http.Response) error {
+	return parseHTML(output, body, res)
+}
+
+// GetDBPage makes an API_GetDBPage call.
+// See https://help.quickbase.com/api-guide/getdbpage.html
+func (c Client) GetDBPage(input *GetDBPageInput) (GetDBPageOutput, error) {
+	return c.GetDBPageWithContext(context.Background(), input)
+}
+
+// GetDBPageWithContext is the same as GetDBPage with the addition of the
+// ability to pass a context.
+func (c Client) GetDBPageWithContext(ctx context.Context, input *GetDBPageInput) (output GetDBPageOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_GetDBPage", output.ResponseParams)
+	}
+	return
+}
+
+// GetNumRecordsInput models the request sent to API_GetNumRecords
+// See https://help.quickbase.com/api-guide/getnumrecords.html
+type GetNumRecordsInput struct {
+	RequestParams
+	Credentials
+
+	TableID string `xml:"-"`
+}
+
+func (input *GetNumRecordsInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *GetNumRecordsInput) method() string                   { return http.MethodPost }
+func (input *GetNumRecordsInput) uri() string                      { return "/db/" + input.TableID }
+func (input *GetNumRecordsInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *GetNumRecordsInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_GetNumRecords")
+}
+
+// GetNumRecordsOutput models the response returned by API_GetNumRecords
+// See https://help.quickbase.com/api-guide/getnumrecords.html
+type GetNumRecordsOutput struct {
+	ResponseParams
+
+	NumRecords int `xml:"num_records" json:"num_records"`
+}
+
+func (output *GetNumRecordsOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// GetNumRecords makes an API_GetNumRecords call.
+// See https://help.quickbase.com/api-guide/getnumrecords.html
+func (c Client) GetNumRecords(input *GetNumRecordsInput) (GetNumRecordsOutput, error) {
+	return c.GetNumRecordsWithContext(context.Background(), input)
+}
+
+// GetNumRecordsWithContext is the same as GetNumRecords with the addition of
+// the ability to pass a context.
+func (c Client) GetNumRecordsWithContext(ctx context.Context, input *GetNumRecordsInput) (output GetNumRecordsOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_GetNumRecords", output.ResponseParams)
+	}
+	return
+}
+
+// GetRecordInfoInput models the request sent to API_GetRecordInfo
+// See https://help.quickbase.com/api-guide/getrecordinfo.html
+type GetRecordInfoInput struct {
+	RequestParams
+	Credentials
+
+	TableID  string `xml:"-"`
+	RecordID int    `xml:"rid,omitempty"`
+	Key      string `xml:"key,omitempty"`
+}
+
+func (input *GetRecordInfoInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *GetRecordInfoInput) method() string                   { return http.MethodPost }
+func (input *GetRecordInfoInput) uri() string                      { return "/db/" + input.TableID }
+func (input *GetRecordInfoInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *GetRecordInfoInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_GetRecordInfo")
+}
+
+// GetRecordInfoOutput models the response returned by API_GetRecordInfo
+// See https://help.quickbase.com/api-guide/getrecordinfo.html
+type GetRecordInfoOutput struct {
+	ResponseParams
+
+	RecordID  int                        `xml:"rid" json:"record_id"`
+	NumFields int                        `xml:"num_fields" json:"num_fields"`
+	UpdateID  int                        `xml:"update_id" json:"update_id"`
+	Fields    []GetRecordInfoOutputField `xml:"field" json:"fields"`
+}
+
+// GetRecordInfoOutputField models the "field" element in API_GetRecordInfo
+// responses.
+type GetRecordInfoOutputField struct {
+	FieldID   int    `xml:"fid" json:"field_id"`
+	Name      string `xml:"name" json:"name"`
+	Type      string `xml:"type" json:"type"`
+	Value     string `xml:"value" json:"value"`
+	Printable string `xml:"printable" json:"printable,omitempty"`
+}
+
+func (output *GetRecordInfoOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// GetRecordInfo makes an API_GetRecordInfo call.
+// See https://help.quickbase.com/api-guide/getrecordinfo.html
+func (c Client) GetRecordInfo(input *GetRecordInfoInput) (GetRecordInfoOutput, error) {
+	return c.GetRecordInfoWithContext(context.Background(), input)
+}
+
+// GetRecordInfoWithContext is the same as GetRecordInfo with the addition of
+// the ability to pass a context.
+func (c Client) GetRecordInfoWithContext(ctx context.Context, input *GetRecordInfoInput) (output GetRecordInfoOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_GetRecordInfo", output.ResponseParams)
+	}
+	return
+}
+
+// GetRoleInfoInput models the request sent to API_GetRoleInfo
+// See https://help.quickbase.com/api-guide/getroleinfo.html
+type GetRoleInfoInput struct {
+	RequestParams
+	Credentials
+
+	AppID string `xml:"-"`
+}
+
+func (input *GetRoleInfoInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *GetRoleInfoInput) method() string                   { return http.MethodPost }
+func (input *GetRoleInfoInput) uri() string                      { return "/db/" + input.AppID }
+func (input *GetRoleInfoInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *GetRoleInfoInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_GetRoleInfo")
+}
+
+// GetRoleInfoOutput models the response returned by API_GetRoleInfo
+// See https://help.quickbase.com/api-guide/getroleinfo.html
+type GetRoleInfoOutput struct {
+	ResponseParams
+
+	Roles []Role `xml:"roles>role" json:"roles"`
+}
+
+func (output *GetRoleInfoOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// GetRoleInfo makes an API_GetRoleInfo call.
+// See https://help.quickbase.com/api-guide/getroleinfo.html
+func (c Client) GetRoleInfo(input *GetRoleInfoInput) (GetRoleInfoOutput, error) {
+	return c.GetRoleInfoWithContext(context.Background(), input)
+}
+
+// GetRoleInfoWithContext is the same as GetRoleInfo with the addition of the
+// ability to pass a context.
+func (c Client) GetRoleInfoWithContext(ctx context.Context, input *GetRoleInfoInput) (output GetRoleInfoOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_GetRoleInfo", output.ResponseParams)
+	}
+	return
+}
+
+// GetSchemaInput models requests sent to API_GetSchema.
+// See https://help.quickbase.com/api-guide/getschema.html
+type GetSchemaInput struct {
+	RequestParams
+	Credentials
+
+	ID string `xml:"-"`
+}
+
+func (input *GetSchemaInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *GetSchemaInput) method() string                   { return http.MethodPost }
+func (input *GetSchemaInput) uri() string                      { return "/db/" + input.ID }
+func (input *GetSchemaInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *GetSchemaInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_GetSchema")
+}
+
+// GetSchemaOutput models responses returned API_GetSchema.
+// See https://help.quickbase.com/api-guide/getschema.html
+type GetSchemaOutput struct {
+	ResponseParams
+
+	TimeZone    string                      `xml:"time_zone" json:"time_zone,omitempty"`
+	DateFormat  string                      `xml:"date_format" json:"date_format,omitempty"`
+	Name        string                      `xml:"table>name" json:"name"`
+	Description string                      `xml:"table>desc" json:"description,omitempty"`
+	Original    GetSchemaOutputOriginal     `xml:"table>original" json:"original"`
+	Variables   []GetSchemaOutputVariable   `xml:"table>variables>var" json:"variables,omitempty"`
+	ChildTables []GetSchemaOutputChildTable `xml:"table>chdbids>chdbid" json:"child_tables,omitempty"`
+	Queries     []GetSchemaOutputQuery      `xml:"table>queries>query" json:"queries,omitempty"`
+	Imports     []GetSchemaOutputImport     `xml:"table>saved_imports>import" json:"imports,omitempty"`
+	Fields      []GetSchemaOutputField      `xml:"table>fields>field" json:"fields,omitempty"`
+}
+
+// GetSchemaOutputOriginal models the "table>original" element in
+// API_GetSchema responses.
+type GetSchemaOutputOriginal struct {
+	TableID            string `xml:"table_id" json:"table_id,omitempty"`
+	AppID              string `xml:"app_id" json:"app_id,omitempty"`
+	CreatedDate        int64  `xml:"cre_date" json:"created_date,omitempty"`
+	ModifiedDate       int64  `xml:"mod_date" json:"modified_date,omitempty"`
+	NextRecordID       int    `xml:"next_record_id" json:"next_record_id,omitempty"`
+	NextFieldID        int    `xml:"next_field_id" json:"next_field_id,omitempty"`
+	NextQueryID        int    `xml:"next_query_id" json:"next_query_id,omitempty"`
+	DefaultSortFieldID int    `xml:"def_sort_fid" json:"default_sort_field_id,omitempty"`
+	DefaultSortOrder   int    `xml:"def_sort_order" json:"default_sort_order,omitempty"`
+	KeyFieldID         int    `xml:"key_fid" json:"key_field_id,omitempty"`
+}
+
+// GetSchemaOutputImport models the "table>saved_imports>import" element in
+// API_GetSchema responses, which is an import saved in the table that can be
+// run with API_RunImport.
+type GetSchemaOutputImport struct {
+	ImportID int    `xml:"id,attr" json:"import_id"`
+	Name     string `xml:",chardata" json:"name"`
+}
+
+// GetSchemaOutputVariable models the "table>variables>var" element in
+// API_GetSchema responses.
+type GetSchemaOutputVariable struct {
+	Name  string `xml:"name,attr" json:"name"`
+	Value string `xml:",chardata" json:"value"`
+}
+
+// GetSchemaOutputChildTable models the "table>chdbids>chdbid" element in
+// API_GetSchema responses.
+type GetSchemaOutputChildTable struct {
+	Name    string `xml:"name,attr" json:"name"`
+	TableID string `xml:",chardata" json:"table_id"`
+}
+
+// GetSchemaOutputQuery models the "table>queries>query" element in
+// API_GetSchema responses, i.e., the table's reports.
+type GetSchemaOutputQuery struct {
+	QueryID     int    `xml:"id,attr" json:"query_id"`
+	Name        string `xml:"qyname" json:"name"`
+	Type        string `xml:"qytype" json:"type"`
+	Description string `xml:"qydesc" json:"description,omitempty"`
+	Criteria    string `xml:"qycrit" json:"criteria,omitempty"`
+	Columns     string `xml:"qyclst" json:"columns,omitempty"`
+	Sort        string `xml:"qyslst" json:"sort,omitempty"`
+	Options     string `xml:"qyopts" json:"options,omitempty"`
+}
+
+// GetSchemaOutputField models the "table>fields>field" element in
+// API_GetSchema responses.
+type GetSchemaOutputField struct {
+	FieldID          int      `xml:"id,attr" json:"field_id"`
+	Type             string   `xml:"field_type,attr" json:"type"`
+	BaseType         string   `xml:"base_type,attr" json:"base_type"`
+	Mode             string   `xml:"mode,attr" json:"mode,omitempty"`
+	Role             string   `xml:"role,attr" json:"role,omitempty"`
+	Label            string   `xml:"label" json:"label"`
+	Help             string   `xml:"fieldhelp" json:"help,omitempty"`
+	Required         bool     `xml:"required" json:"required"`
+	Unique           bool     `xml:"unique" json:"unique"`
+	AppearsByDefault bool     `xml:"appears_by_default" json:"appears_by_default"`
+	FindEnabled      bool     `xml:"find_enabled" json:"find_enabled"`
+	DoesDataCopy     bool     `xml:"doesdatacopy" json:"does_data_copy"`
+	DefaultValue     string   `xml:"default_value" json:"default_value,omitempty"`
+	Formula          string   `xml:"formula" json:"formula,omitempty"`
+	Choices          []string `xml:"choices>choice" json:"choices,omitempty"`
+	AllowNewChoices  bool     `xml:"allow_new_choices" json:"allow_new_choices"`
+
+	// ForeignKey and MasterTableID are set on reference fields, i.e., the
+	// field in a detail table that relates records to the master table.
+	ForeignKey    bool   `xml:"foreignkey" json:"foreign_key"`
+	MasterTableID string `xml:"mastag" json:"master_table_id,omitempty"`
+
+	// LookupReferenceFieldID and LookupSourceFieldID are set on lookup
+	// fields and contain the reference field in this table and the field in
+	// the master table that the value is looked up from.
+	LookupReferenceFieldID int `xml:"lutfid" json:"lookup_reference_field_id,omitempty"`
+	LookupSourceFieldID    int `xml:"lusfid" json:"lookup_source_field_id,omitempty"`
+
+	// Summary* properties are set on summary fields.
+	SummaryFunction         string `xml:"summaryFunction" json:"summary_function,omitempty"`
+	SummaryTargetFieldID    int    `xml:"summaryTargetFid" json:"summary_target_field_id,omitempty"`
+	SummaryReferenceFieldID int    `xml:"summaryReferenceFid" json:"summary_reference_field_id,omitempty"`
+
+	// Target* and SourceFieldID are set on report link fields.
+	TargetTableID string `xml:"target_dbid" json:"target_table_id,omitempty"`
+	TargetFieldID int    `xml:"target_fid" json:"target_field_id,omitempty"`
+	SourceFieldID int    `xml:"source_fid" json:"source_field_id,omitempty"`
+}
+
+// Field returns the field with the passed ID.
+func (output GetSchemaOutput) Field(id int) (GetSchemaOutputField, bool) {
+	for _, f := range output.Fields {
+		if f.FieldID == id {
+			return f, true
+		}
+	}
+	return GetSchemaOutputField{}, false
+}
+
+// KeyField returns the table's key field.
+func (output GetSchemaOutput) KeyField() (GetSchemaOutputField, bool) {
+	return output.Field(output.Original.KeyFieldID)
+}
+
+func (output *GetSchemaOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// GetSchema makes call to API_GetSchema.
+// See https://help.quickbase.com/api-guide/getschema.html
+func (c Client) GetSchema(input *GetSchemaInput) (GetSchemaOutput, error) {
+	return c.GetSchemaWithContext(context.Background(), input)
+}
+
+// GetSchemaWithContext is the same as GetSchema with the addition of the
+// ability to pass a context.
+func (c Client) GetSchemaWithContext(ctx context.Context, input *GetSchemaInput) (output GetSchemaOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_GetSchema", output.ResponseParams)
+	}
+	return
+}
+
+// GetUserInfoInput models the request sent to API_GetUserInfo
+// See https://help.quickbase.com/api-guide/getuserinfo.html
+type GetUserInfoInput struct {
+	RequestParams
+	Credentials
+
+	// Email is the email address or screen name of the user. The current user
+	// is returned if Email is empty.
+	Email string `xml:"email,omitempty"`
+}
+
+func (input *GetUserInfoInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *GetUserInfoInput) method() string                   { return http.MethodPost }
+func (input *GetUserInfoInput) uri() string                      { return "/db/main" }
+func (input *GetUserInfoInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *GetUserInfoInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_GetUserInfo")
+}
+
+// GetUserInfoOutput models the response returned by API_GetUserInfo
+// See https://help.quickbase.com/api-guide/getuserinfo.html
+type GetUserInfoOutput struct {
+	ResponseParams
+
+	User GetUserInfoOutputUser `xml:"user" json:"user"`
+}
+
+// GetUserInfoOutputUser models the "user" element in API_GetUserInfo
+// responses.
+type GetUserInfoOutputUser struct {
+	UserID     string `xml:"id,attr" json:"user_id"`
+	FirstName  string `xml:"firstName" json:"first_name"`
+	LastName   string `xml:"lastName" json:"last_name"`
+	Login      string `xml:"login" json:"login"`
+	Email      string `xml:"email" json:"email"`
+	ScreenName string `xml:"screenName" json:"screen_name"`
+	IsVerified bool   `xml:"isVerified" json:"is_verified"`
+}
+
+func (output *GetUserInfoOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// GetUserInfo makes an API_GetUserInfo call.
+// See https://help.quickbase.com/api-guide/getuserinfo.html
+func (c Client) GetUserInfo(input *GetUserInfoInput) (GetUserInfoOutput, error) {
+	return c.GetUserInfoWithContext(context.Background(), input)
+}
+
+// GetUserInfoWithContext is the same as GetUserInfo with the addition of the
+// ability to pass a context.
+func (c Client) GetUserInfoWithContext(ctx context.Context, input *GetUserInfoInput) (output GetUserInfoOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_GetUserInfo", output.ResponseParams)
+	}
+	return
+}
+
+// GetUserRoleInput models the request sent to API_GetUserRole
+// See https://help.quickbase.com/api-guide/getuserrole.html
+type GetUserRoleInput struct {
+	RequestParams
+	Credentials
+
+	AppID         string `xml:"-"`
+	UserID        string `xml:"userid"`
+	IncludeGroups Bool   `xml:"inclgrps,omitempty"`
+}
+
+func (input *GetUserRoleInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *GetUserRoleInput) method() string                   { return http.MethodPost }
+func (input *GetUserRoleInput) uri() string                      { return "/db/" + input.AppID }
+func (input *GetUserRoleInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *GetUserRoleInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_GetUserRole")
+}
+
+// GetUserRoleOutput models the response returned by API_GetUserRole
+// See https://help.quickbase.com/api-guide/getuserrole.html
+type GetUserRoleOutput struct {
+	ResponseParams
+
+	User UserRolesOutputUser `xml:"user" json:"user"`
+}
+
+func (output *GetUserRoleOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// GetUserRole makes an API_GetUserRole call.
+// See https://help.quickbase.com/api-guide/getuserrole.html
+func (c Client) GetUserRole(input *GetUserRoleInput) (GetUserRoleOutput, error) {
+	return c.GetUserRoleWithContext(context.Background(), input)
+}
+
+// GetUserRoleWithContext is the same as GetUserRole with the addition of the
+// ability to pass a context.
+func (c Client) GetUserRoleWithContext(ctx context.Context, input *GetUserRoleInput) (output GetUserRoleOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_GetUserRole", output.ResponseParams)
+	}
+	return
+}
+
+// GetVariableInput models the request sent to API_GetDBvar
+// See https://help.quickbase.com/api-guide/getdbvar.html
+type GetVariableInput struct {
+	RequestParams
+	Credentials
+
+	AppID string `xml:"-"`
+	Name  string `xml:"varname"`
+}
+
+func (input *GetVariableInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *GetVariableInput) method() string                   { return http.MethodPost }
+func (input *GetVariableInput) uri() string                      { return "/db/" + input.AppID }
+func (input *GetVariableInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *GetVariableInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_GetDBvar")
+}
+
+// GetVariableOutput models the response returned by API_GetDBvar
+// See https://help.quickbase.com/api-guide/getdbvar.html
+type GetVariableOutput struct {
+	ResponseParams
+
+	Value string `xml:"value" json:"value"`
+}
+
+func (output *GetVariableOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// GetVariable makes an API_GetDBvar call.
+// See https://help.quickbase.com/api-guide/getdbvar.html
+func (c Client) GetVariable(input *GetVariableInput) (GetVariableOutput, error) {
+	return c.GetVariableWithContext(context.Background(), input)
+}
+
+// GetVariableWithContext is the same as GetVariable with the addition of the
+// ability to pass a context.
+func (c Client) GetVariableWithContext(ctx context.Context, input *GetVariableInput) (output GetVariableOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_GetDBvar", output.ResponseParams)
+	}
+	return
+}
+
+// GrantedDBsInput models the request sent to API_GrantedDBs
+// See https://help.quickbase.com/api-guide/granteddbs.html
+type GrantedDBsInput struct {
+	RequestParams
+	Credentials
+
+	AdminOnly        Bool `xml:"adminOnly,omitempty"`
+	ExcludeParents   Bool `xml:"excludeparents,omitempty"`
+	IncludeAncestors Bool `xml:"includeancestors,omitempty"`
+	RealmAppsOnly    Bool `xml:"realmAppsOnly,omitempty"`
+
+	// WithEmbeddedTables includes child tables in the response. Unlike the
+	// other options, it is always sent because Quick Base defaults to true.
+	WithEmbeddedTables Bool `xml:"withembeddedtables"`
+}
+
+func (input *GrantedDBsInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *GrantedDBsInput) method() string                   { return http.MethodPost }
+func (input *GrantedDBsInput) uri() string                      { return "/db/main" }
+func (input *GrantedDBsInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *GrantedDBsInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_GrantedDBs")
+}
+
+// GrantedDBsOutput models the response returned by API_GrantedDBs
+// See https://help.quickbase.com/api-guide/granteddbs.html
+type GrantedDBsOutput struct {
+	ResponseParams
+
+	Databases []GrantedDBsOutputDatabase `xml:"databases>dbinfo" json:"databases"`
+}
+
+// GrantedDBsOutputDatabase models the "databases>dbinfo" element in
+// API_GrantedDBs responses.
+type GrantedDBsOutputDatabase struct {
+	Name                string `xml:"dbname" json:"name"`
+	ID                  string `xml:"dbid" json:"id"`
+	AncestorAppID       string `xml:"ancestorappid" json:"ancestor_app_id,omitempty"`
+	OldestAncestorAppID string `xml:"oldestancestorappid" json:"oldest_ancestor_app_id,omitempty"`
+}
+
+func (output *GrantedDBsOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// GrantedDBs makes an API_GrantedDBs call.
+// See https://help.quickbase.com/api-guide/granteddbs.html
+func (c Client) GrantedDBs(input *GrantedDBsInput) (GrantedDBsOutput, error) {
+	return c.GrantedDBsWithContext(context.Background(), input)
+}
+
+// GrantedDBsWithContext is the same as GrantedDBs with the addition of the
+// ability to pass a context.
+func (c Client) GrantedDBsWithContext(ctx context.Context, input *GrantedDBsInput) (output GrantedDBsOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_GrantedDBs", output.ResponseParams)
+	}
+	return
+}
+
+// ImportFromCSVInput models the request sent to API_ImportFromCSV
+// See https://help.quickbase.com/api-guide/importfromcsv.html
+type ImportFromCSVInput struct {
+	RequestParams
+	Credentials
+
+	TableID          string                     `xml:"-"`
+	FieldList        FieldList                  `xml:"clist,omitempty"`
+	OutputFieldList  FieldList                  `xml:"clist_output,omitempty"`
+	MergeFieldID     int                        `xml:"mergeFieldId,omitempty"`
+	DecimalAsPercent Bool                       `xml:"decimalPercent,omitempty"`
+	Records          *ImportFromCSVInputRecords `xml:"records_CSV"`
+	SkipFirstRow     Bool                       `xml:"skipfirst,omitempty"`
+}
+
+func (input *ImportFromCSVInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *ImportFromCSVInput) method() string                   { return http.MethodPost }
+func (input *ImportFromCSVInput) uri() string                      { return "/db/" + input.TableID }
+func (input *ImportFromCSVInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *ImportFromCSVInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_ImportFromCSV")
+}
+
+// EnsureRecords returns an initialized Records property. This method should be
+// used in favor of accessing the property directly to avoid null pointer
+// exceptions.
+func (input *ImportFromCSVInput) EnsureRecords() *ImportFromCSVInputRecords {
+	if input.Records == nil {
+		input.Records = &ImportFromCSVInputRecords{}
+	}
+	return input.Records
+}
+
+// CSV sets raw CSV data.
+func (input *ImportFromCSVInput) CSV(csv []byte) {
+	input.EnsureRecords().CSV = string(csv)
+}
+
+// FormatCSV converts a string slice slice ([][]string) into CSV data.
+func (input *ImportFromCSVInput) FormatCSV(records [][]string) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.WriteAll(records)
+	input.EnsureRecords().CSV = buf.String()
+}
+
+// ImportFromCSVInputRecords models the "records_CSV" element in
+// API_ImportFromCSV requests.
+type ImportFromCSVInputRecords struct {
+	CSV string `xml:",cdata"`
+}
+
+// ImportFromCSVOutput models the response returned by API_ImportFromCSV
+// See https://help.quickbase.com/api-guide/importfromcsv.html
+type ImportFromCSVOutput struct {
+	ResponseParams
+
+	NumRecordsAdded   int                         `xml:"num_recs_added"`
+	NumRecordsInput   int                         `xml:"num_recs_input"`
+	NumRecordsUpdated int                         `xml:"num_recs_updated"`
+	Records           []ImportFromCSVOutputRecord `xml:"rids>rid,omitempty"`
+}
+
+func (output *ImportFromCSVOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// ImportFromCSVOutputRecord models the "rids>rid" element in API_ImportFromCSV
+// responses.
+type ImportFromCSVOutputRecord struct {
+	ID       int `xml:",chardata"`
+	UpdateID int `xml:"update_id,attr"`
+}
+
+// ImportFromCSV makes an API_ImportFromCSV call.
+// See https://help.quickbase.com/api-guide/importfromcsv.html
+func (c Client) ImportFromCSV(input *ImportFromCSVInput) (ImportFromCSVOutput, error) {
+	return c.ImportFromCSVWithContext(context.Background(), input)
+}
+
+// ImportFromCSVWithContext is the same as ImportFromCSV with the addition of the
+// ability to pass a context.
+func (c Client) ImportFromCSVWithContext(ctx context.Context, input *ImportFromCSVInput) (output ImportFromCSVOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_ImportFromCSV", output.ResponseParams)
+	}
+	return
+}
+
+// ListDBPagesInput models the request sent to API_ListDBPages
+// See https://help.quickbase.com/api-guide/listdbpages.html
+type ListDBPagesInput struct {
+	RequestParams
+	Credentials
+
+	AppID string `xml:"-"`
+}
+
+func (input *ListDBPagesInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *ListDBPagesInput) method() string                   { return http.MethodPost }
+func (input *ListDBPagesInput) uri() string                      { return "/db/" + input.AppID }
+func (input *ListDBPagesInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *ListDBPagesInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_ListDBPages")
+}
+
+// ListDBPagesOutput models the response returned by API_ListDBPages
+// See https://help.quickbase.com/api-guide/listdbpages.html
+type ListDBPagesOutput struct {
+	ResponseParams
+
+	Pages []ListDBPagesOutputPage `xml:"pages>page" json:"pages"`
+}
+
+// ListDBPagesOutputPage models the "pages>page" element in API_ListDBPages
+// responses. Type is one of the PageType* constants.
+type ListDBPagesOutputPage struct {
+	PageID int    `xml:"id,attr" json:"page_id"`
+	Type   int    `xml:"type,attr" json:"page_type"`
+	Name   string `xml:",chardata" json:"name"`
+}
+
+func (output *ListDBPagesOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// ListDBPages makes an API_ListDBPages call.
+// See https://help.quickbase.com/api-guide/listdbpages.html
+func (c Client) ListDBPages(input *ListDBPagesInput) (ListDBPagesOutput, error) {
+	return c.ListDBPagesWithContext(context.Background(), input)
+}
+
+// ListDBPagesWithContext is the same as ListDBPages with the addition of the
+// ability to pass a context.
+func (c Client) ListDBPagesWithContext(ctx context.Context, input *ListDBPagesInput) (output ListDBPagesOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_ListDBPages", output.ResponseParams)
+	}
+	return
+}
+
+// ProvisionUserInput models the request sent to API_ProvisionUser
+// See https://help.quickbase.com/api-guide/provisionuser.html
+type ProvisionUserInput struct {
+	RequestParams
+	Credentials
+
+	AppID     string `xml:"-"`
+	Email     string `xml:"email"`
+	FirstName string `xml:"fname,omitempty"`
+	LastName  string `xml:"lname,omitempty"`
+	RoleID    int    `xml:"roleid,omitempty"`
+}
+
+func (input *ProvisionUserInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *ProvisionUserInput) method() string                   { return http.MethodPost }
+func (input *ProvisionUserInput) uri() string                      { return "/db/" + input.AppID }
+func (input *ProvisionUserInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *ProvisionUserInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_ProvisionUser")
+}
+
+// ProvisionUserOutput models the response returned by API_ProvisionUser
+// See https://help.quickbase.com/api-guide/provisionuser.html
+type ProvisionUserOutput struct {
+	ResponseParams
+
+	UserID string `xml:"userid" json:"user_id"`
+}
+
+func (output *ProvisionUserOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// ProvisionUser makes an API_ProvisionUser call.
+// See https://help.quickbase.com/api-guide/provisionuser.html
+func (c Client) ProvisionUser(input *ProvisionUserInput) (ProvisionUserOutput, error) {
+	return c.ProvisionUserWithContext(context.Background(), input)
+}
+
+// ProvisionUserWithContext is the same as ProvisionUser with the addition of
+// the ability to pass a context.
+func (c Client) ProvisionUserWithContext(ctx context.Context, input *ProvisionUserInput) (output ProvisionUserOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_ProvisionUser", output.ResponseParams)
+	}
+	return
+}
+
+// PurgeRecordsInput models the request sent to API_PurgeRecords. All
+// records in the table are deleted if no query is set.
+// See https://help.quickbase.com/api-guide/purge_records.html
+type PurgeRecordsInput struct {
+	RequestParams
+	Credentials
+
+	TableID   string `xml:"-"`
+	Query     string `xml:"query,omitempty"`
+	QueryID   int    `xml:"qid,omitempty"`
+	QueryName string `xml:"qname,omitempty"`
+}
+
+func (input *PurgeRecordsInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *PurgeRecordsInput) method() string                   { return http.MethodPost }
+func (input *PurgeRecordsInput) uri() string                      { return "/db/" + input.TableID }
+func (input *PurgeRecordsInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *PurgeRecordsInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_PurgeRecords")
+}
+
+// PurgeRecordsOutput models the response returned by API_PurgeRecords
+// See https://help.quickbase.com/api-guide/purge_records.html
+type PurgeRecordsOutput struct {
+	ResponseParams
+
+	NumRecordsDeleted int `xml:"num_records_deleted" json:"num_records_deleted"`
+}
+
+func (output *PurgeRecordsOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
 }
 
-// DoQueryOutput models the response returned by API_DoQuery.
-// See https://help.quickbase.com/api-guide/do_query.html.
-type DoQueryOutput struct {
-	ResponseParams
+// PurgeRecords makes an API_PurgeRecords call.
+// See https://help.quickbase.com/api-guide/purge_records.html
+func (c Client) PurgeRecords(input *PurgeRecordsInput) (PurgeRecordsOutput, error) {
+	return c.PurgeRecordsWithContext(context.Background(), input)
+}
 
-	Fields  []DoQueryOutputField  `xml:"table>fields>field"`
-	Records []DoQueryOutputRecord `xml:"table>records>record"`
+// PurgeRecordsWithContext is the same as PurgeRecords with the addition of
+// the ability to pass a context.
+func (c Client) PurgeRecordsWithContext(ctx context.Context, input *PurgeRecordsInput) (output PurgeRecordsOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_PurgeRecords", output.ResponseParams)
+	}
+	return
 }
 
-// DoQueryOutputField models the "table>fields" element. in an API_DoQuery
-// response.
-type DoQueryOutputField struct {
-	FieldID  int    `xml:"id,attr"`
-	Type     string `xml:"field_type,attr"`
-	BaseType string `xml:"base_type,attr"`
-	Mode     string `xml:"mode,attr"`
-	Label    string `xml:"label"`
+// RemoveUserFromRoleInput models the request sent to API_RemoveUserFromRole
+// See https://help.quickbase.com/api-guide/removeuserfromrole.html
+type RemoveUserFromRoleInput struct {
+	RequestParams
+	Credentials
+
+	AppID  string `xml:"-"`
+	UserID string `xml:"userid"`
+	RoleID int    `xml:"roleid"`
 }
 
-// DoQueryOutputRecord models the "table>records>record" element in an
-// API_DoQuery response.
-type DoQueryOutputRecord struct {
-	RecordID int                        `xml:"rid,attr"`
-	UpdateID int                        `xml:"update_id"`
-	Fields   []DoQueryOutputRecordField `xml:"f"`
+func (input *RemoveUserFromRoleInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *RemoveUserFromRoleInput) method() string                   { return http.MethodPost }
+func (input *RemoveUserFromRoleInput) uri() string                      { return "/db/" + input.AppID }
+func (input *RemoveUserFromRoleInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *RemoveUserFromRoleInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_RemoveUserFromRole")
 }
 
-// DoQueryOutputRecordField models the "table>records>record>field" element
-// in an API_DoQuery response.
-type DoQueryOutputRecordField struct {
-	FieldID int    `xml:"id,attr"`
-	Value   string `xml:",chardata"`
+// RemoveUserFromRoleOutput models the response returned by API_RemoveUserFromRole
+// See https://help.quickbase.com/api-guide/removeuserfromrole.html
+type RemoveUserFromRoleOutput struct {
+	ResponseParams
 }
 
-func (output *DoQueryOutput) parse(body []byte, res *http.Response) error {
+func (output *RemoveUserFromRoleOutput) parse(body []byte, res *http.Response) error {
 	return parseXML(output, body, res)
 }
 
-// DoQuery makes call to API_DoQuery.
-// See https://help.quickbase.com/api-guide/do_query.html.
-func (c Client) DoQuery(input *DoQueryInput) (output DoQueryOutput, err error) {
-	// Required for predictable output.
-	input.Format = "structured"
-	input.IncludeRecordIDs = true
+// RemoveUserFromRole makes an API_RemoveUserFromRole call.
+// See https://help.quickbase.com/api-guide/removeuserfromrole.html
+func (c Client) RemoveUserFromRole(input *RemoveUserFromRoleInput) (RemoveUserFromRoleOutput, error) {
+	return c.RemoveUserFromRoleWithContext(context.Background(), input)
+}
 
-	err = c.Do(input, &output)
+// RemoveUserFromRoleWithContext is the same as RemoveUserFromRole with the
+// addition of the ability to pass a context.
+func (c Client) RemoveUserFromRoleWithContext(ctx context.Context, input *RemoveUserFromRoleInput) (output RemoveUserFromRoleOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
 	if err == nil && output.ErrorCode != 0 {
-		err = fmt.Errorf("error executing API_DoQuery: %s (error code: %v)", output.ErrorText, output.ErrorCode)
+		err = newAPIError("API_RemoveUserFromRole", output.ResponseParams)
 	}
 	return
 }
 
-// EditRecordInput models the request sent to API_EditRecord.
-// See https://help.quickbase.com/api-guide/edit_record.html
-type EditRecordInput struct {
+// RenameAppInput models the request sent to API_RenameApp
+// See https://help.quickbase.com/api-guide/renameapp.html
+type RenameAppInput struct {
 	RequestParams
 	Credentials
 
-	TableID           string                 `xml:"-"`
-	DisplayRecord     bool                   `xml:"disprec,int,omitempty"`
-	SetCheckboxFields bool                   `xml:"fform,int,omitempty"`
-	Fields            []EditRecordInputField `xml:"field"`
-	IgnoreError       bool                   `xml:"ignoreError,int,omitempty"`
-	MillisecondsInUtc bool                   `xml:"msInUTC,int,omitempty"`
-	RecordID          int                    `xml:"rid"`
-	UpdateID          int                    `xml:"update_id,omitempty"`
+	AppID string `xml:"-"`
+	Name  string `xml:"newappname"`
 }
 
-func (input *EditRecordInput) setCredentials(creds Credentials) { input.Credentials = creds }
-func (input *EditRecordInput) method() string                   { return http.MethodPost }
-func (input *EditRecordInput) uri() string                      { return "/db/" + input.TableID }
-func (input *EditRecordInput) payload() ([]byte, error)         { return xml.Marshal(input) }
-func (input *EditRecordInput) headers(req *http.Request) {
+func (input *RenameAppInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *RenameAppInput) method() string                   { return http.MethodPost }
+func (input *RenameAppInput) uri() string                      { return "/db/" + input.AppID }
+func (input *RenameAppInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *RenameAppInput) headers(req *http.Request) {
 	req.Header.Set("Content-Type", "application/xml")
-	req.Header.Set("QUICKBASE-ACTION", "API_EditRecord")
-}
-
-// EditRecordInputField models the "field" element in API_EditRecord requests.
-type EditRecordInputField struct {
-	ID       int    `xml:"fid,attr,omitempty"`
-	FileName string `xml:"filename,attr,omitempty"`
-	Label    string `xml:"name,attr,omitempty"`
-	Value    string `xml:",chardata"`
+	req.Header.Set("QUICKBASE-ACTION", "API_RenameApp")
 }
 
-// EditRecordOutput models the response returned by API_EditRecord
-// See https://help.quickbase.com/api-guide/edit_record.html
-type EditRecordOutput struct {
+// RenameAppOutput models the response returned by API_RenameApp
+// See https://help.quickbase.com/api-guide/renameapp.html
+type RenameAppOutput struct {
 	ResponseParams
-
-	NumFieldsChanged int `xml:"num_fields_changed" json:"num_fields_changed"`
-	RecordID         int `xml:"rid" json:"record_id"`
-	UpdateID         int `xml:"update_id" json:"update_id"`
 }
 
-func (output *EditRecordOutput) parse(body []byte, res *http.Response) error {
+func (output *RenameAppOutput) parse(body []byte, res *http.Response) error {
 	return parseXML(output, body, res)
 }
 
-// EditRecord makes an API_EditRecord call.
-// See https://help.quickbase.com/api-guide/edit_record.html
-func (c Client) EditRecord(input *EditRecordInput) (output EditRecordOutput, err error) {
-	err = c.Do(input, &output)
+// RenameApp makes an API_RenameApp call.
+// See https://help.quickbase.com/api-guide/renameapp.html
+func (c Client) RenameApp(input *RenameAppInput) (RenameAppOutput, error) {
+	return c.RenameAppWithContext(context.Background(), input)
+}
+
+// RenameAppWithContext is the same as RenameApp with the addition of the
+// ability to pass a context.
+func (c Client) RenameAppWithContext(ctx context.Context, input *RenameAppInput) (output RenameAppOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
 	if err == nil && output.ErrorCode != 0 {
-		err = fmt.Errorf("error executing API_EditRecord: %s (error code: %v)", output.ErrorText, output.ErrorCode)
+		err = newAPIError("API_RenameApp", output.ResponseParams)
 	}
 	return
 }
 
-// GetSchemaInput models requests sent to API_GetSchema.
-// See https://help.quickbase.com/api-guide/getschema.html
-type GetSchemaInput struct {
+// RunImportInput models the request sent to API_RunImport
+// See https://help.quickbase.com/api-guide/runimport.html
+type RunImportInput struct {
 	RequestParams
 	Credentials
 
-	ID string `xml:"-"`
+	// TableID is the table the records are imported into.
+	TableID  string `xml:"-"`
+	ImportID int    `xml:"id"`
 }
 
-func (input *GetSchemaInput) setCredentials(creds Credentials) { input.Credentials = creds }
-func (input *GetSchemaInput) method() string                   { return http.MethodPost }
-func (input *GetSchemaInput) uri() string                      { return "/db/" + input.ID }
-func (input *GetSchemaInput) payload() ([]byte, error)         { return xml.Marshal(input) }
-func (input *GetSchemaInput) headers(req *http.Request) {
+func (input *RunImportInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *RunImportInput) method() string                   { return http.MethodPost }
+func (input *RunImportInput) uri() string                      { return "/db/" + input.TableID }
+func (input *RunImportInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *RunImportInput) headers(req *http.Request) {
 	req.Header.Set("Content-Type", "application/xml")
-	req.Header.Set("QUICKBASE-ACTION", "API_GetSchema")
+	req.Header.Set("QUICKBASE-ACTION", "API_RunImport")
 }
 
-// GetSchemaOutput models responses returned API_GetSchema.
-// See https://help.quickbase.com/api-guide/getschema.html
-type GetSchemaOutput struct {
+// RunImportOutput models the response returned by API_RunImport
+// See https://help.quickbase.com/api-guide/runimport.html
+type RunImportOutput struct {
 	ResponseParams
 
-	Fields []DoQueryOutputField `xml:"table>fields>field"`
+	// Status describes the result, e.g. "3 new records were created."
+	Status string `xml:"import_status" json:"status"`
 }
 
-func (output *GetSchemaOutput) parse(body []byte, res *http.Response) error {
+func (output *RunImportOutput) parse(body []byte, res *http.Response) error {
 	return parseXML(output, body, res)
 }
 
-// GetSchema makes call to API_GetSchema.
-// See https://help.quickbase.com/api-guide/getschema.html
-func (c Client) GetSchema(input *GetSchemaInput) (output GetSchemaOutput, err error) {
-	err = c.Do(input, &output)
+// RunImport makes an API_RunImport call.
+// See https://help.quickbase.com/api-guide/runimport.html
+func (c Client) RunImport(input *RunImportInput) (RunImportOutput, error) {
+	return c.RunImportWithContext(context.Background(), input)
+}
+
+// RunImportWithContext is the same as RunImport with the addition of the
+// ability to pass a context.
+func (c Client) RunImportWithContext(ctx context.Context, input *RunImportInput) (output RunImportOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
 	if err == nil && output.ErrorCode != 0 {
-		err = fmt.Errorf("error executing API_GetSchema: %s (error code: %v)", output.ErrorText, output.ErrorCode)
+		err = newAPIError("API_RunImport", output.ResponseParams)
 	}
 	return
 }
 
-// ImportFromCSVInput models the request sent to API_ImportFromCSV
-// See https://help.quickbase.com/api-guide/importfromcsv.html
-type ImportFromCSVInput struct {
+// SendInvitationInput models the request sent to API_SendInvitation
+// See https://help.quickbase.com/api-guide/sendinvitation.html
+type SendInvitationInput struct {
 	RequestParams
 	Credentials
 
-	TableID          string                     `xml:"-"`
-	FieldList        FieldList                  `xml:"clist,omitempty"`
-	OutputFieldList  FieldList                  `xml:"clist_output,omitempty"`
-	MergeFieldID     int                        `xml:"mergeFieldId,omitempty"`
-	DecimalAsPercent Bool                       `xml:"decimalPercent,omitempty"`
-	Records          *ImportFromCSVInputRecords `xml:"records_CSV"`
-	SkipFirstRow     Bool                       `xml:"skipfirst,omitempty"`
+	AppID    string `xml:"-"`
+	UserID   string `xml:"userid"`
+	UserText string `xml:"usertext,omitempty"`
 }
 
-func (input *ImportFromCSVInput) setCredentials(creds Credentials) { input.Credentials = creds }
-func (input *ImportFromCSVInput) method() string                   { return http.MethodPost }
-func (input *ImportFromCSVInput) uri() string                      { return "/db/" + input.TableID }
-func (input *ImportFromCSVInput) payload() ([]byte, error)         { return xml.Marshal(input) }
-func (input *ImportFromCSVInput) headers(req *http.Request) {
+func (input *SendInvitationInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *SendInvitationInput) method() string                   { return http.MethodPost }
+func (input *SendInvitationInput) uri() string                      { return "/db/" + input.AppID }
+func (input *SendInvitationInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *SendInvitationInput) headers(req *http.Request) {
 	req.Header.Set("Content-Type", "application/xml")
-	req.Header.Set("QUICKBASE-ACTION", "API_ImportFromCSV")
+	req.Header.Set("QUICKBASE-ACTION", "API_SendInvitation")
 }
 
-// EnsureRecords returns an initialized Records property. This method should be
-// used in favor of accessing the property directly to avoid null pointer
-// exceptions.
-func (input *ImportFromCSVInput) EnsureRecords() *ImportFromCSVInputRecords {
-	if input.Records == nil {
-		input.Records = &ImportFromCSVInputRecords{}
+// SendInvitationOutput models the response returned by API_SendInvitation
+// See https://help.quickbase.com/api-guide/sendinvitation.html
+type SendInvitationOutput struct {
+	ResponseParams
+}
+
+func (output *SendInvitationOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// SendInvitation makes an API_SendInvitation call.
+// See https://help.quickbase.com/api-guide/sendinvitation.html
+func (c Client) SendInvitation(input *SendInvitationInput) (SendInvitationOutput, error) {
+	return c.SendInvitationWithContext(context.Background(), input)
+}
+
+// SendInvitationWithContext is the same as SendInvitation with the addition
+// of the ability to pass a context.
+func (c Client) SendInvitationWithContext(ctx context.Context, input *SendInvitationInput) (output SendInvitationOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_SendInvitation", output.ResponseParams)
 	}
-	return input.Records
+	return
 }
 
-// CSV sets raw CSV data.
-func (input *ImportFromCSVInput) CSV(csv []byte) {
-	input.EnsureRecords().CSV = string(csv)
+// SetFieldPropertiesInput models the request sent to API_SetFieldProperties
+// See https://help.quickbase.com/api-guide/setfieldproperties.html
+type SetFieldPropertiesInput struct {
+	RequestParams
+	Credentials
+
+	TableID    string          `xml:"-"`
+	FieldID    int             `xml:"fid"`
+	Properties FieldProperties `xml:"properties"`
 }
 
-// FormatCSV converts a string slice slice ([][]string) into CSV data.
-func (input *ImportFromCSVInput) FormatCSV(records [][]string) {
-	var buf bytes.Buffer
-	w := csv.NewWriter(&buf)
-	w.WriteAll(records)
-	input.EnsureRecords().CSV = buf.String()
+func (input *SetFieldPropertiesInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *SetFieldPropertiesInput) method() string                   { return http.MethodPost }
+func (input *SetFieldPropertiesInput) uri() string                      { return "/db/" + input.TableID }
+func (input *SetFieldPropertiesInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *SetFieldPropertiesInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_SetFieldProperties")
 }
 
-// ImportFromCSVInputRecords models the "records_CSV" element in
-// API_ImportFromCSV requests.
-type ImportFromCSVInputRecords struct {
-	CSV string `xml:",cdata"`
+// FieldProperties models the field properties set in API_SetFieldProperties
+// requests, keyed by property name, e.g. "label" or "required".
+type FieldProperties map[string]string
+
+// MarshalXML implements Marshaler.MarshalXML and renders each property as an
+// element in the request, sorted by name for predictable output.
+func (p FieldProperties) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := e.EncodeElement(p[name], xml.StartElement{Name: xml.Name{Local: name}}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// ImportFromCSVOutput models the response returned by API_ImportFromCSV
-// See https://help.quickbase.com/api-guide/importfromcsv.html
-type ImportFromCSVOutput struct {
+// SetFieldPropertiesOutput models the response returned by
+// API_SetFieldProperties
+// See https://help.quickbase.com/api-guide/setfieldproperties.html
+type SetFieldPropertiesOutput struct {
 	ResponseParams
-
-	NumRecordsAdded   int                         `xml:"num_recs_added"`
-	NumRecordsInput   int                         `xml:"num_recs_input"`
-	NumRecordsUpdated int                         `xml:"num_recs_updated"`
-	Records           []ImportFromCSVOutputRecord `xml:"rids>rid,omitempty"`
 }
 
-func (output *ImportFromCSVOutput) parse(body []byte, res *http.Response) error {
+func (output *SetFieldPropertiesOutput) parse(body []byte, res *http.Response) error {
 	return parseXML(output, body, res)
 }
 
-// ImportFromCSVOutputRecord models the "rids>rid" element in API_ImportFromCSV
-// responses.
-type ImportFromCSVOutputRecord struct {
-	ID       int `xml:",chardata"`
-	UpdateID int `xml:"update_id,attr"`
+// SetFieldProperties makes an API_SetFieldProperties call.
+// See https://help.quickbase.com/api-guide/setfieldproperties.html
+func (c Client) SetFieldProperties(input *SetFieldPropertiesInput) (SetFieldPropertiesOutput, error) {
+	return c.SetFieldPropertiesWithContext(context.Background(), input)
 }
 
-// ImportFromCSV makes an API_ImportFromCSV call.
-// See https://help.quickbase.com/api-guide/importfromcsv.html
-func (c Client) ImportFromCSV(input *ImportFromCSVInput) (output ImportFromCSVOutput, err error) {
-	err = c.Do(input, &output)
+// SetFieldPropertiesWithContext is the same as SetFieldProperties with the
+// addition of the ability to pass a context.
+func (c Client) SetFieldPropertiesWithContext(ctx context.Context, input *SetFieldPropertiesInput) (output SetFieldPropertiesOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
 	if err == nil && output.ErrorCode != 0 {
-		err = fmt.Errorf("error executing API_ImportFromCSV: %s (error code: %v)", output.ErrorText, output.ErrorCode)
+		err = newAPIError("API_SetFieldProperties", output.ResponseParams)
 	}
 	return
 }
@@ -492,10 +2430,58 @@ func (output *SetVariableOutput) parse(body []byte, res *http.Response) error {
 
 // SetVariable makes an API_SetDBvar call.
 // See https://help.quickbase.com/api-guide/setdbvar.html
-func (c Client) SetVariable(input *SetVariableInput) (output SetVariableOutput, err error) {
-	err = c.Do(input, &output)
+func (c Client) SetVariable(input *SetVariableInput) (SetVariableOutput, error) {
+	return c.SetVariableWithContext(context.Background(), input)
+}
+
+// SetVariableWithContext is the same as SetVariable with the addition of the
+// ability to pass a context.
+func (c Client) SetVariableWithContext(ctx context.Context, input *SetVariableInput) (output SetVariableOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_SetDBvar", output.ResponseParams)
+	}
+	return
+}
+
+// SignOutInput models the request sent to API_SignOut
+// See https://help.quickbase.com/api-guide/signout.html
+type SignOutInput struct {
+	RequestParams
+	Credentials
+}
+
+func (input *SignOutInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *SignOutInput) method() string                   { return http.MethodPost }
+func (input *SignOutInput) uri() string                      { return "/db/main" }
+func (input *SignOutInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *SignOutInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_SignOut")
+}
+
+// SignOutOutput models the response returned by API_SignOut
+// See https://help.quickbase.com/api-guide/signout.html
+type SignOutOutput struct {
+	ResponseParams
+}
+
+func (output *SignOutOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// SignOut makes an API_SignOut call.
+// See https://help.quickbase.com/api-guide/signout.html
+func (c Client) SignOut(input *SignOutInput) (SignOutOutput, error) {
+	return c.SignOutWithContext(context.Background(), input)
+}
+
+// SignOutWithContext is the same as SignOut with the addition of the
+// ability to pass a context.
+func (c Client) SignOutWithContext(ctx context.Context, input *SignOutInput) (output SignOutOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
 	if err == nil && output.ErrorCode != 0 {
-		err = fmt.Errorf("error executing API_SetDBvar: %s (error code: %v)", output.ErrorText, output.ErrorCode)
+		err = newAPIError("API_SignOut", output.ResponseParams)
 	}
 	return
 }
@@ -520,11 +2506,177 @@ func (input *UploadFileInput) headers(req *http.Request) {
 	req.Header.Set("QUICKBASE-ACTION", "API_UploadFile")
 }
 
+// body implements StreamingInput.body. Files that are read via Open are base64
+// encoded as the payload is streamed, so they aren't read into memory. The
+// files are opened before the request is sent so that the payload's size is
+// known if the size of every file is known.
+func (input *UploadFileInput) body() (io.Reader, int64, error) {
+	streaming := false
+	for _, f := range input.Fields {
+		if f.Open != nil {
+			streaming = true
+			break
+		}
+	}
+
+	if !streaming {
+		b, err := input.payload()
+		return bytes.NewBuffer(b), int64(len(b)), err
+	}
+
+	// Marshal everything but the fields, which are written before the closing
+	// tag of the root element.
+	head := *input
+	head.Fields = nil
+	b, err := xml.Marshal(&head)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	end := []byte("</qdbapi>")
+	parts := []uploadPart{{data: bytes.TrimSuffix(b, end)}}
+	for _, f := range input.Fields {
+		fp, err := f.parts()
+		if err != nil {
+			closeParts(parts)
+			return nil, 0, err
+		}
+		parts = append(parts, fp...)
+	}
+	parts = append(parts, uploadPart{data: end})
+
+	var size int64
+	for _, p := range parts {
+		if p.size() < 0 {
+			size = -1
+			break
+		}
+		size += p.size()
+	}
+
+	pr, pw := io.Pipe()
+	go func() { pw.CloseWithError(writeParts(pw, parts)) }()
+	return pr, size, nil
+}
+
 // UploadFileInputField models the "field" element in API_UploadFile requests.
 type UploadFileInputField struct {
 	ID       int    `xml:"fid,attr"`
 	FileData string `xml:",chardata"`
 	Name     string `xml:"filename,attr"`
+
+	// Open returns the file being uploaded, which is base64 encoded as the
+	// request is sent. FileData is ignored if Open is set. Open is invoked
+	// once per attempt and the file is closed after it is read.
+	Open func() (io.ReadCloser, error) `xml:"-"`
+}
+
+// parts returns the parts of the payload that make up the field.
+func (f UploadFileInputField) parts() ([]uploadPart, error) {
+	var buf bytes.Buffer
+	enc := xml.NewEncoder(&buf)
+	start := xml.StartElement{Name: xml.Name{Local: "field"}}
+
+	if f.Open == nil {
+		if err := enc.EncodeElement(f, start); err != nil {
+			return nil, err
+		}
+		if err := enc.Flush(); err != nil {
+			return nil, err
+		}
+		return []uploadPart{{data: buf.Bytes()}}, nil
+	}
+
+	start.Attr = []xml.Attr{
+		{Name: xml.Name{Local: "fid"}, Value: strconv.Itoa(f.ID)},
+		{Name: xml.Name{Local: "filename"}, Value: f.Name},
+	}
+	if err := enc.EncodeToken(start); err != nil {
+		return nil, err
+	}
+	if err := enc.Flush(); err != nil {
+		return nil, err
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+
+	return []uploadPart{
+		{data: buf.Bytes()},
+		{file: rc, fileSize: readerSize(rc)},
+		{data: []byte("</field>")},
+	}, nil
+}
+
+// uploadPart is part of a streamed API_UploadFile payload, either data that
+// is written as-is or a file that is base64 encoded.
+type uploadPart struct {
+	data     []byte
+	file     io.ReadCloser
+	fileSize int64
+}
+
+// size returns the number of bytes the part adds to the payload, or -1 if it
+// is unknown.
+func (p uploadPart) size() int64 {
+	if p.file == nil {
+		return int64(len(p.data))
+	}
+	if p.fileSize < 0 {
+		return -1
+	}
+	return int64(base64.StdEncoding.EncodedLen(int(p.fileSize)))
+}
+
+// writeParts writes the parts to w, base64 encoding the files. Base64
+// encoded data doesn't need to be escaped, so it is written directly to w.
+// The files are closed whether or not they were written.
+func writeParts(w io.Writer, parts []uploadPart) error {
+	defer closeParts(parts)
+
+	for _, p := range parts {
+		if p.file == nil {
+			if _, err := w.Write(p.data); err != nil {
+				return err
+			}
+			continue
+		}
+
+		b64 := base64.NewEncoder(base64.StdEncoding, w)
+		if _, err := io.Copy(b64, p.file); err != nil {
+			return err
+		}
+		if err := b64.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// closeParts closes the parts' files.
+func closeParts(parts []uploadPart) {
+	for _, p := range parts {
+		if p.file != nil {
+			p.file.Close()
+		}
+	}
+}
+
+// readerSize returns the number of bytes that can be read from r, or -1 if it
+// is unknown.
+func readerSize(r io.Reader) int64 {
+	switch t := r.(type) {
+	case interface{ Stat() (os.FileInfo, error) }:
+		if info, err := t.Stat(); err == nil && info.Mode().IsRegular() {
+			return info.Size()
+		}
+	case interface{ Len() int }:
+		return int64(t.Len())
+	}
+	return -1
 }
 
 // UploadFileOutput models the response returned by API_UploadFile
@@ -548,10 +2700,74 @@ type UploadFileOutputField struct {
 
 // UploadFile makes an API_UploadFile call.
 // See https://help.quickbase.com/api-guide/uploadfile.html
-func (c Client) UploadFile(input *UploadFileInput) (output UploadFileOutput, err error) {
-	err = c.Do(input, &output)
+func (c Client) UploadFile(input *UploadFileInput) (UploadFileOutput, error) {
+	return c.UploadFileWithContext(context.Background(), input)
+}
+
+// UploadFileWithContext is the same as UploadFile with the addition of the
+// ability to pass a context.
+func (c Client) UploadFileWithContext(ctx context.Context, input *UploadFileInput) (output UploadFileOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
+	if err == nil && output.ErrorCode != 0 {
+		err = newAPIError("API_UploadFile", output.ResponseParams)
+	}
+	return
+}
+
+// UserRolesInput models the request sent to API_UserRoles
+// See https://help.quickbase.com/api-guide/userroles.html
+type UserRolesInput struct {
+	RequestParams
+	Credentials
+
+	AppID string `xml:"-"`
+}
+
+func (input *UserRolesInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *UserRolesInput) method() string                   { return http.MethodPost }
+func (input *UserRolesInput) uri() string                      { return "/db/" + input.AppID }
+func (input *UserRolesInput) payload() ([]byte, error)         { return xml.Marshal(input) }
+func (input *UserRolesInput) headers(req *http.Request) {
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("QUICKBASE-ACTION", "API_UserRoles")
+}
+
+// UserRolesOutput models the response returned by API_UserRoles
+// See https://help.quickbase.com/api-guide/userroles.html
+type UserRolesOutput struct {
+	ResponseParams
+
+	Users []UserRolesOutputUser `xml:"users>user" json:"users"`
+}
+
+// UserRolesOutputUser models the "user" element in API_UserRoles and
+// API_GetUserRole responses. The user is a group if Type is "group".
+type UserRolesOutputUser struct {
+	UserID     string `xml:"id,attr" json:"user_id"`
+	Type       string `xml:"type,attr" json:"type,omitempty"`
+	Name       string `xml:"name" json:"name"`
+	FirstName  string `xml:"firstName" json:"first_name,omitempty"`
+	LastName   string `xml:"lastName" json:"last_name,omitempty"`
+	LastAccess int64  `xml:"lastAccess" json:"last_access,omitempty"`
+	Roles      []Role `xml:"roles>role" json:"roles"`
+}
+
+func (output *UserRolesOutput) parse(body []byte, res *http.Response) error {
+	return parseXML(output, body, res)
+}
+
+// UserRoles makes an API_UserRoles call.
+// See https://help.quickbase.com/api-guide/userroles.html
+func (c Client) UserRoles(input *UserRolesInput) (UserRolesOutput, error) {
+	return c.UserRolesWithContext(context.Background(), input)
+}
+
+// UserRolesWithContext is the same as UserRoles with the addition of the
+// ability to pass a context.
+func (c Client) UserRolesWithContext(ctx context.Context, input *UserRolesInput) (output UserRolesOutput, err error) {
+	err = c.DoWithContext(ctx, input, &output)
 	if err == nil && output.ErrorCode != 0 {
-		err = fmt.Errorf("error executing API_UploadFile: %s (error code: %v)", output.ErrorText, output.ErrorCode)
+		err = newAPIError("API_UserRoles", output.ResponseParams)
 	}
 	return
 }
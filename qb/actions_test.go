@@ -0,0 +1,896 @@
+package qb
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// doQueryPagesHandler returns an http.HandlerFunc that serves API_DoQuery
+// responses for a table containing total records, honoring the "skp" and
+// "num" options.
+func doQueryPagesHandler(t *testing.T, total int, requests *int) http.HandlerFunc {
+	re := regexp.MustCompile(`skp-([0-9]+)|num-([0-9]+)`)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("error reading request body: %s", err)
+		}
+
+		skp, num := 0, 0
+		for _, m := range re.FindAllStringSubmatch(string(body), -1) {
+			if m[1] != "" {
+				skp, _ = strconv.Atoi(m[1])
+			}
+			if m[2] != "" {
+				num, _ = strconv.Atoi(m[2])
+			}
+		}
+
+		records := ""
+		for rid := skp + 1; rid <= total && rid <= skp+num; rid++ {
+			records += fmt.Sprintf(`<record rid="%v"><update_id>1</update_id><f id="3">%v</f></record>`, rid, rid)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<?xml version="1.0" ?>
+			<qdbapi>
+				<action>API_DoQuery</action>
+				<errcode>0</errcode>
+				<errtext>No error</errtext>
+				<table><records>` + records + `</records></table>
+			</qdbapi>`))
+	}
+}
+
+func TestDoQueryPages(t *testing.T) {
+	tests := []struct {
+		total    int
+		limit    int
+		offset   int
+		pages    int
+		records  int
+		requests int
+		last     bool
+	}{
+		{total: 5, limit: 2, pages: 3, records: 5, requests: 3, last: true},
+		{total: 4, limit: 2, pages: 2, records: 4, requests: 3, last: false},
+		{total: 5, limit: 2, offset: 1, pages: 2, records: 4, requests: 3, last: false},
+		{total: 5, limit: 2, offset: 2, pages: 2, records: 3, requests: 2, last: true},
+		{total: 0, limit: 2, pages: 1, records: 0, requests: 1, last: true},
+		{total: 5, pages: 1, records: 5, requests: 1, last: true},
+	}
+
+	for _, test := range tests {
+		requests := 0
+		server, client := NewServerClientPair(doQueryPagesHandler(t, test.total, &requests))
+
+		input := &DoQueryInput{TableID: "bpdhfphi2"}
+		input.Offset(test.offset)
+		input.Limit(test.limit)
+
+		pages, records, last := 0, 0, false
+		err := client.DoQueryPages(input, func(output DoQueryOutput, lastPage bool) bool {
+			pages++
+			records += len(output.Records)
+			last = lastPage
+			return true
+		})
+		server.Close()
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if pages != test.pages {
+			t.Errorf("%+v: expected %v pages, got %v", test, test.pages, pages)
+		}
+		if records != test.records {
+			t.Errorf("%+v: expected %v records, got %v", test, test.records, records)
+		}
+		if requests != test.requests {
+			t.Errorf("%+v: expected %v requests, got %v", test, test.requests, requests)
+		}
+		if last != test.last {
+			t.Errorf("%+v: expected lastPage to be %v, got %v", test, test.last, last)
+		}
+		if input.Options.Offset != test.offset {
+			t.Errorf("%+v: expected the input to not be modified", test)
+		}
+	}
+}
+
+func TestDoQueryPagesStop(t *testing.T) {
+	requests := 0
+	server, client := NewServerClientPair(doQueryPagesHandler(t, 10, &requests))
+	defer server.Close()
+
+	input := &DoQueryInput{TableID: "bpdhfphi2"}
+	input.Limit(2)
+
+	err := client.DoQueryPages(input, func(output DoQueryOutput, lastPage bool) bool {
+		return false
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected iteration to stop after 1 request, got %v", requests)
+	}
+}
+
+func TestDoQueryPagesNilOptions(t *testing.T) {
+	requests := 0
+	server, client := NewServerClientPair(doQueryPagesHandler(t, 5, &requests))
+	defer server.Close()
+
+	input := &DoQueryInput{TableID: "bpdhfphi2"}
+	err := client.DoQueryPages(input, func(output DoQueryOutput, lastPage bool) bool {
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if input.Options != nil {
+		t.Errorf("expected the input to not be modified, got options %+v", input.Options)
+	}
+}
+
+// actionHandler returns an http.HandlerFunc that asserts the request is for
+// the action, stores the request body in body, and responds with the XML
+// elements in response.
+func actionHandler(t *testing.T, action string, body *string, response string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if a := r.Header.Get("QUICKBASE-ACTION"); a != action {
+			t.Errorf("expected action %s, got %s", action, a)
+		}
+
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("error reading request body: %s", err)
+		}
+		*body = r.URL.Path + " " + string(b)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`<?xml version="1.0" ?>
+			<qdbapi>
+				<action>` + action + `</action>
+				<errcode>0</errcode>
+				<errtext>No error</errtext>
+				` + response + `
+			</qdbapi>`))
+	}
+}
+
+func TestDeleteRecord(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_DeleteRecord", &body, `<rid>12</rid>`))
+	defer server.Close()
+
+	out, err := client.DeleteRecord(&DeleteRecordInput{TableID: "bpdhfphi2", RecordID: 12})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^/db/bpdhfphi2 .*<rid>12</rid>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.RecordID != 12 {
+		t.Errorf("expected record ID 12, got %v", out.RecordID)
+	}
+}
+
+func TestDoQueryCount(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_DoQueryCount", &body, `<numMatches>42</numMatches>`))
+	defer server.Close()
+
+	out, err := client.DoQueryCount(&DoQueryCountInput{TableID: "bpdhfphi2", Query: "{7.EX.'a'}"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^/db/bpdhfphi2 .*<query>{7.EX.&#39;a&#39;}</query>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.NumMatches != 42 {
+		t.Errorf("expected 42 matches, got %v", out.NumMatches)
+	}
+}
+
+func TestPurgeRecords(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_PurgeRecords", &body, `<num_records_deleted>3</num_records_deleted>`))
+	defer server.Close()
+
+	out, err := client.PurgeRecords(&PurgeRecordsInput{TableID: "bpdhfphi2", QueryID: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^/db/bpdhfphi2 .*<qid>5</qid>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.NumRecordsDeleted != 3 {
+		t.Errorf("expected 3 records deleted, got %v", out.NumRecordsDeleted)
+	}
+}
+
+func TestGetRecordInfo(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_GetRecordInfo", &body, `
+		<rid>12</rid>
+		<num_fields>2</num_fields>
+		<update_id>1205780029699</update_id>
+		<field><fid>3</fid><name>Record ID#</name><type>Record ID#</type><value>12</value></field>
+		<field><fid>7</fid><name>Due Date</name><type>Date</type><value>1205733600000</value><printable>03-17-2008</printable></field>`))
+	defer server.Close()
+
+	out, err := client.GetRecordInfo(&GetRecordInfoInput{TableID: "bpdhfphi2", RecordID: 12})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^/db/bpdhfphi2 .*<rid>12</rid>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.RecordID != 12 || out.NumFields != 2 || out.UpdateID != 1205780029699 {
+		t.Errorf("unexpected output: %+v", out)
+	}
+
+	expected := GetRecordInfoOutputField{FieldID: 7, Name: "Due Date", Type: "Date", Value: "1205733600000", Printable: "03-17-2008"}
+	if len(out.Fields) != 2 || out.Fields[1] != expected {
+		t.Errorf("expected field %+v, got %+v", expected, out.Fields)
+	}
+}
+
+func TestGetNumRecords(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_GetNumRecords", &body, `<num_records>1000</num_records>`))
+	defer server.Close()
+
+	out, err := client.GetNumRecords(&GetNumRecordsInput{TableID: "bpdhfphi2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^/db/bpdhfphi2 `).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.NumRecords != 1000 {
+		t.Errorf("expected 1000 records, got %v", out.NumRecords)
+	}
+}
+
+func TestAddField(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_AddField", &body, `<fid>8</fid><label>Due Date</label>`))
+	defer server.Close()
+
+	out, err := client.AddField(&AddFieldInput{TableID: "bpdhfphi2", Label: "Due Date", Type: FieldTypeDate})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^/db/bpdhfphi2 .*<label>Due Date</label><type>date</type></qdbapi>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.FieldID != 8 || out.Label != "Due Date" {
+		t.Errorf("unexpected output: %+v", out)
+	}
+}
+
+func TestSetFieldProperties(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_SetFieldProperties", &body, ``))
+	defer server.Close()
+
+	_, err := client.SetFieldProperties(&SetFieldPropertiesInput{
+		TableID:    "bpdhfphi2",
+		FieldID:    8,
+		Properties: FieldProperties{"unique": "1", "label": "Due"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`<fid>8</fid><label>Due</label><unique>1</unique></qdbapi>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+}
+
+func TestFieldChoices(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_FieldAddChoices", &body, `<fid>9</fid><fname>Status</fname><numadded>2</numadded>`))
+	defer server.Close()
+
+	out, err := client.FieldAddChoices(&FieldAddChoicesInput{TableID: "bpdhfphi2", FieldID: 9, Choices: []string{"Open", "In progress"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`<fid>9</fid><choice>Open</choice><choice>In progress</choice>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.FieldID != 9 || out.FieldName != "Status" || out.NumAdded != 2 {
+		t.Errorf("unexpected output: %+v", out)
+	}
+}
+
+func TestGetSchema(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_GetSchema", &body, `
+		<table>
+			<name>Tasks</name>
+			<original>
+				<table_id>bpdhfphi2</table_id>
+				<app_id>bpdhfngx3</app_id>
+				<key_fid>6</key_fid>
+			</original>
+			<variables><var name="Color">Blue</var></variables>
+			<queries>
+				<query id="1"><qyname>List All</qyname><qytype>table</qytype><qycrit>{'0'.CT.''}</qycrit></query>
+			</queries>
+			<fields>
+				<field id="6" field_type="text" base_type="text">
+					<label>Name</label>
+					<required>1</required>
+					<unique>1</unique>
+				</field>
+				<field id="7" field_type="text" base_type="text">
+					<label>Status</label>
+					<required>0</required>
+					<default_value>Open</default_value>
+					<choices><choice>Open</choice><choice>Closed</choice></choices>
+				</field>
+				<field id="8" field_type="text" base_type="text" mode="lookup">
+					<label>Project Name</label>
+					<mastag>bpdhfpq5c</mastag>
+					<lutfid>9</lutfid>
+					<lusfid>6</lusfid>
+				</field>
+			</fields>
+		</table>`))
+	defer server.Close()
+
+	out, err := client.GetSchema(&GetSchemaInput{ID: "bpdhfphi2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if out.Name != "Tasks" || out.Original.AppID != "bpdhfngx3" {
+		t.Errorf("unexpected table: %+v", out)
+	}
+	if len(out.Variables) != 1 || out.Variables[0].Value != "Blue" {
+		t.Errorf("unexpected variables: %+v", out.Variables)
+	}
+	if len(out.Queries) != 1 || out.Queries[0].QueryID != 1 || out.Queries[0].Name != "List All" {
+		t.Errorf("unexpected queries: %+v", out.Queries)
+	}
+	if len(out.Fields) != 3 {
+		t.Fatalf("expected 3 fields, got %v", len(out.Fields))
+	}
+
+	if f, ok := out.KeyField(); !ok || f.Label != "Name" || !f.Required || !f.Unique {
+		t.Errorf("unexpected key field: %+v", f)
+	}
+	if f := out.Fields[1]; f.Required || f.DefaultValue != "Open" || len(f.Choices) != 2 {
+		t.Errorf("unexpected field: %+v", f)
+	}
+	if f := out.Fields[2]; f.MasterTableID != "bpdhfpq5c" || f.LookupReferenceFieldID != 9 || f.LookupSourceFieldID != 6 {
+		t.Errorf("unexpected lookup field: %+v", f)
+	}
+}
+
+func TestGrantedDBs(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_GrantedDBs", &body, `
+		<databases>
+			<dbinfo><dbname>Projects</dbname><dbid>bpdhfngx3</dbid></dbinfo>
+			<dbinfo><dbname>Inventory</dbname><dbid>bpdhfq7vk</dbid></dbinfo>
+		</databases>`))
+	defer server.Close()
+
+	out, err := client.GrantedDBs(&GrantedDBsInput{AdminOnly: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^/db/main .*<adminOnly>1</adminOnly><withembeddedtables>0</withembeddedtables></qdbapi>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if len(out.Databases) != 2 || out.Databases[1].Name != "Inventory" || out.Databases[1].ID != "bpdhfq7vk" {
+		t.Errorf("unexpected databases: %+v", out.Databases)
+	}
+}
+
+func TestGetDBInfo(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_GetDBInfo", &body, `
+		<dbname>Projects</dbname>
+		<lastRecModTime>1205806751959</lastRecModTime>
+		<lastModifiedTime>1205877093679</lastModifiedTime>
+		<createdTime>1204745351407</createdTime>
+		<numRecords>3</numRecords>
+		<mgrID>112149.bhsv</mgrID>
+		<mgrName>AppBoss</mgrName>`))
+	defer server.Close()
+
+	out, err := client.GetDBInfo(&GetDBInfoInput{ID: "bpdhfngx3"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.HasPrefix(body, "/db/bpdhfngx3 ") {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.Name != "Projects" || out.NumRecords != 3 || out.LastModifiedTime != 1205877093679 || out.ManagerName != "AppBoss" {
+		t.Errorf("unexpected output: %+v", out)
+	}
+}
+
+func TestGetVariable(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_GetDBvar", &body, `<value>on</value>`))
+	defer server.Close()
+
+	out, err := client.GetVariable(&GetVariableInput{AppID: "bpdhfngx3", Name: "feature_x"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^/db/bpdhfngx3 .*<varname>feature_x</varname></qdbapi>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.Value != "on" {
+		t.Errorf("expected value on, got %s", out.Value)
+	}
+}
+
+func TestUploadFileStreaming(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_UploadFile", &body, `
+		<file_fields>
+			<field id="9"><url>https://example.quickbase.com/up/bpdhfphi2/a/r12/e9/v0</url></field>
+			<field id="10"><url>https://example.quickbase.com/up/bpdhfphi2/a/r12/e10/v0</url></field>
+		</file_fields>`))
+	defer server.Close()
+
+	opened := false
+	out, err := client.UploadFile(&UploadFileInput{
+		TableID:  "bpdhfphi2",
+		RecordID: 12,
+		Fields: []UploadFileInputField{
+			{ID: 9, Name: "a & b.txt", Open: func() (io.ReadCloser, error) {
+				opened = true
+				return ioutil.NopCloser(strings.NewReader("hello")), nil
+			}},
+			{ID: 10, Name: "c.txt", FileData: "d29ybGQ="},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !opened {
+		t.Error("expected file to be opened")
+	}
+	expected := `<rid>12</rid><field fid="9" filename="a &amp; b.txt">aGVsbG8=</field><field fid="10" filename="c.txt">d29ybGQ=</field></qdbapi>`
+	if !strings.HasSuffix(body, expected) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if len(out.Fields) != 2 || out.Fields[1].ID != 10 {
+		t.Errorf("unexpected output: %+v", out)
+	}
+}
+
+func TestUploadFileOpenError(t *testing.T) {
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+	})
+	defer server.Close()
+
+	_, err := client.UploadFile(&UploadFileInput{
+		TableID:  "bpdhfphi2",
+		RecordID: 12,
+		Fields: []UploadFileInputField{
+			{ID: 9, Name: "a.txt", Open: func() (io.ReadCloser, error) {
+				return nil, errors.New("file not found")
+			}},
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "file not found") {
+		t.Errorf("expected error opening file, got %v", err)
+	}
+}
+
+func TestUploadFileContentLength(t *testing.T) {
+	f, err := ioutil.TempFile("", "upload")
+	if err != nil {
+		t.Fatalf("error creating file: %s", err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("hello world")
+	f.Close()
+
+	var length int64
+	var body []byte
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		length = r.ContentLength
+		body, _ = ioutil.ReadAll(r.Body)
+		w.Write([]byte(`<qdbapi><action>API_UploadFile</action><errcode>0</errcode></qdbapi>`))
+	})
+	defer server.Close()
+
+	_, err = client.UploadFile(&UploadFileInput{
+		TableID:  "bpdhfphi2",
+		RecordID: 12,
+		Fields: []UploadFileInputField{
+			{ID: 9, Name: "hello.txt", Open: func() (io.ReadCloser, error) { return os.Open(f.Name()) }},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if length != int64(len(body)) {
+		t.Errorf("expected content length %v, got %v", len(body), length)
+	}
+	if !strings.Contains(string(body), `<field fid="9" filename="hello.txt">aGVsbG8gd29ybGQ=</field>`) {
+		t.Errorf("unexpected request: %s", body)
+	}
+}
+
+func TestCreateDatabase(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_CreateDatabase", &body, `
+		<dbid>bpdhfq7vk</dbid>
+		<appdbid>bpdhfq7vk</appdbid>
+		<apptoken>cmzaaz3dgdmmwwksdb7zcd7a9wg</apptoken>`))
+	defer server.Close()
+
+	out, err := client.CreateDatabase(&CreateDatabaseInput{Name: "Sandbox", CreateAppToken: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^/db/main .*<dbname>Sandbox</dbname><createapptoken>1</createapptoken></qdbapi>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.AppID != "bpdhfq7vk" || out.AppToken != "cmzaaz3dgdmmwwksdb7zcd7a9wg" {
+		t.Errorf("unexpected output: %+v", out)
+	}
+}
+
+func TestCloneDatabase(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_CloneDatabase", &body, `<newdbid>bpdhfq7vk</newdbid>`))
+	defer server.Close()
+
+	out, err := client.CloneDatabase(&CloneDatabaseInput{AppID: "bpdhfngx3", Name: "Sandbox", KeepData: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^/db/bpdhfngx3 .*<newdbname>Sandbox</newdbname><keepData>1</keepData></qdbapi>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.AppID != "bpdhfq7vk" {
+		t.Errorf("expected app ID bpdhfq7vk, got %s", out.AppID)
+	}
+}
+
+func TestUserRoles(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_UserRoles", &body, `
+		<users>
+			<user type="user" id="112149.bhsv">
+				<name>Jack Danielsson</name>
+				<firstName>Jack</firstName>
+				<lastName>Danielsson</lastName>
+				<lastAccess>1403035235243</lastAccess>
+				<roles>
+					<role id="12"><name>Administrator</name><access id="1">Administrator</access></role>
+				</roles>
+			</user>
+			<user type="group" id="2345.ilho">
+				<name>Viewers</name>
+				<roles>
+					<role id="10"><name>Viewer</name><access id="3">Basic Access</access></role>
+					<role id="11"><name>Participant</name><access id="3">Basic Access</access></role>
+				</roles>
+			</user>
+		</users>`))
+	defer server.Close()
+
+	out, err := client.UserRoles(&UserRolesInput{AppID: "bpdhfngx3"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.HasPrefix(body, "/db/bpdhfngx3 ") {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if len(out.Users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(out.Users))
+	}
+
+	u := out.Users[0]
+	if u.UserID != "112149.bhsv" || u.Type != "user" || u.FirstName != "Jack" || u.LastAccess != 1403035235243 {
+		t.Errorf("unexpected user: %+v", u)
+	}
+	if len(u.Roles) != 1 || u.Roles[0].RoleID != 12 || u.Roles[0].Access.AccessID != 1 || u.Roles[0].Access.Name != "Administrator" {
+		t.Errorf("unexpected roles: %+v", u.Roles)
+	}
+	if g := out.Users[1]; g.Type != "group" || len(g.Roles) != 2 || g.Roles[1].Name != "Participant" {
+		t.Errorf("unexpected group: %+v", g)
+	}
+}
+
+func TestGetUserRole(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_GetUserRole", &body, `
+		<user id="112149.bhsv">
+			<name>Jack Danielsson</name>
+			<roles>
+				<role id="11"><name>Participant</name><access id="3">Basic Access</access></role>
+			</roles>
+		</user>`))
+	defer server.Close()
+
+	out, err := client.GetUserRole(&GetUserRoleInput{AppID: "bpdhfngx3", UserID: "112149.bhsv", IncludeGroups: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^/db/bpdhfngx3 .*<userid>112149.bhsv</userid><inclgrps>1</inclgrps></qdbapi>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.User.Name != "Jack Danielsson" || len(out.User.Roles) != 1 || out.User.Roles[0].RoleID != 11 {
+		t.Errorf("unexpected user: %+v", out.User)
+	}
+}
+
+func TestChangeUserRole(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_ChangeUserRole", &body, ``))
+	defer server.Close()
+
+	_, err := client.ChangeUserRole(&ChangeUserRoleInput{AppID: "bpdhfngx3", UserID: "112149.bhsv", RoleID: 11})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Omitting newroleid sets the user's role to "None".
+	if !regexp.MustCompile(`<userid>112149.bhsv</userid><roleid>11</roleid></qdbapi>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+}
+
+func TestProvisionUser(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_ProvisionUser", &body, `<userid>112248.5nzg</userid>`))
+	defer server.Close()
+
+	out, err := client.ProvisionUser(&ProvisionUserInput{
+		AppID:     "bpdhfngx3",
+		Email:     "jdoe@example.com",
+		FirstName: "John",
+		LastName:  "Doe",
+		RoleID:    11,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`<email>jdoe@example.com</email><fname>John</fname><lname>Doe</lname><roleid>11</roleid></qdbapi>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.UserID != "112248.5nzg" {
+		t.Errorf("expected user ID 112248.5nzg, got %s", out.UserID)
+	}
+}
+
+func TestCopyMasterDetail(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_CopyMasterDetail", &body, `
+		<parentrid>10</parentrid>
+		<numCreated>4</numCreated>`))
+	defer server.Close()
+
+	out, err := client.CopyMasterDetail(&CopyMasterDetailInput{
+		TableID:        "bpdhfpq5c",
+		SourceRecordID: 3,
+		CopyFieldID:    6,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A destrid of 0 creates a copy of the source record, so it is always sent.
+	if !regexp.MustCompile(`^/db/bpdhfpq5c .*<destrid>0</destrid><sourcerid>3</sourcerid><copyfid>6</copyfid></qdbapi>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.ParentRecordID != 10 || out.NumCreated != 4 {
+		t.Errorf("unexpected output: %+v", out)
+	}
+}
+
+func TestListDBPages(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_ListDBPages", &body, `
+		<pages>
+			<page id="6" type="1">newstuff.html</page>
+			<page id="3" type="3">Exact Form</page>
+		</pages>`))
+	defer server.Close()
+
+	out, err := client.ListDBPages(&ListDBPagesInput{AppID: "bpdhfngx3"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.HasPrefix(body, "/db/bpdhfngx3 ") {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if len(out.Pages) != 2 {
+		t.Fatalf("expected 2 pages, got %d", len(out.Pages))
+	}
+	if p := out.Pages[0]; p.PageID != 6 || p.Type != PageTypeCode || p.Name != "newstuff.html" {
+		t.Errorf("unexpected page: %+v", p)
+	}
+	if p := out.Pages[1]; p.Type != PageTypeExactForm {
+		t.Errorf("unexpected page: %+v", p)
+	}
+}
+
+func TestGetDBPage(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		w.Header().Set("QUICKBASE-ERRCODE", "0")
+		w.Header().Set("QUICKBASE-ERRTEXT", "No error")
+		w.Write([]byte("<html><body>Hello</body></html>"))
+	})
+	defer server.Close()
+
+	out, err := client.GetDBPage(&GetDBPageInput{AppID: "bpdhfngx3", PageID: "newstuff.html"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !strings.Contains(body, "<pageID>newstuff.html</pageID>") {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.Content != "<html><body>Hello</body></html>" {
+		t.Errorf("unexpected content: %s", out.Content)
+	}
+}
+
+func TestGetDBPageError(t *testing.T) {
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<?xml version="1.0" ?>
+			<qdbapi>
+				<action>API_GetDBPage</action>
+				<errcode>2</errcode>
+				<errtext>Invalid input</errtext>
+			</qdbapi>`))
+	})
+	defer server.Close()
+
+	out, err := client.GetDBPage(&GetDBPageInput{AppID: "bpdhfngx3", PageID: "missing.html"})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if out.ErrorCode != 2 || out.Content != "" {
+		t.Errorf("unexpected output: %+v", out)
+	}
+}
+
+func TestAddReplaceDBPage(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_AddReplaceDBPage", &body, `<pageID>7</pageID>`))
+	defer server.Close()
+
+	out, err := client.AddReplaceDBPage(&AddReplaceDBPageInput{
+		AppID:    "bpdhfngx3",
+		PageName: "index.html",
+		PageType: PageTypeCode,
+		PageBody: "<b>hi</b>",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`<pagename>index.html</pagename><pagetype>1</pagetype><pagebody>&lt;b&gt;hi&lt;/b&gt;</pagebody></qdbapi>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.PageID != 7 {
+		t.Errorf("expected page ID 7, got %d", out.PageID)
+	}
+}
+
+func TestRunImport(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_RunImport", &body, `<import_status>3 new records were created.</import_status>`))
+	defer server.Close()
+
+	out, err := client.RunImport(&RunImportInput{TableID: "bpdhfphi2", ImportID: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^/db/bpdhfphi2 .*<id>10</id></qdbapi>`).MatchString(body) {
+		t.Errorf("unexpected request: %s", body)
+	}
+	if out.Status != "3 new records were created." {
+		t.Errorf("unexpected status: %s", out.Status)
+	}
+}
+
+func TestGetSchemaImports(t *testing.T) {
+	var body string
+	server, client := NewServerClientPair(actionHandler(t, "API_GetSchema", &body, `
+		<table>
+			<name>Tasks</name>
+			<saved_imports>
+				<import id="10">Copy open tasks</import>
+				<import id="11">Archive</import>
+			</saved_imports>
+		</table>`))
+	defer server.Close()
+
+	out, err := client.GetSchema(&GetSchemaInput{ID: "bpdhfphi2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(out.Imports) != 2 {
+		t.Fatalf("expected 2 imports, got %d", len(out.Imports))
+	}
+	if i := out.Imports[0]; i.ImportID != 10 || i.Name != "Copy open tasks" {
+		t.Errorf("unexpected import: %+v", i)
+	}
+}
+
+func TestGetDBPageContainsAPIResponse(t *testing.T) {
+	page := `<html><script>var sample = "<qdbapi><errcode>0</errcode></qdbapi>";</script></html>`
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(page))
+	})
+	defer server.Close()
+
+	out, err := client.GetDBPage(&GetDBPageInput{AppID: "bpdhfngx3", PageID: "parser.html"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if out.Content != page {
+		t.Errorf("unexpected content: %s", out.Content)
+	}
+}
+
+func TestGetDBPageStylesheet(t *testing.T) {
+	page := `<?xml version="1.0"?><xsl:stylesheet version="1.0"></xsl:stylesheet>`
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(page))
+	})
+	defer server.Close()
+
+	out, err := client.GetDBPage(&GetDBPageInput{AppID: "bpdhfngx3", PageID: "style.xsl"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if out.Content != page {
+		t.Errorf("unexpected content: %s", out.Content)
+	}
+}
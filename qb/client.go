@@ -5,8 +5,11 @@ import (
 	"context"
 	"encoding/json"
 	"encoding/xml"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
 )
@@ -37,6 +40,10 @@ type Client struct {
 
 	// Plugins contains the Plugin implementations.
 	Plugins []Plugin
+
+	// Retry is the policy used to retry requests that fail with a transient
+	// error. Requests are not retried by default.
+	Retry RetryPolicy
 }
 
 // NewClient returns a Client populated with default values.
@@ -55,63 +62,135 @@ func (c Client) Config() Config { return c.config }
 // set, the Input struct is marshaled into the XML/JSON/HTML payload, and the
 // URL of the action being performed is constructed.
 func (c Client) NewRequest(input Input) (req *http.Request, err error) {
+	return c.NewRequestWithContext(context.Background(), input)
+}
+
+// NewRequestWithContext is the same as NewRequest with the addition of the
+// ability to pass a context, which is attached to the *http.Request.
+func (c Client) NewRequestWithContext(ctx context.Context, input Input) (req *http.Request, err error) {
 
 	if i, ok := input.(AuthenticatedInput); ok {
 		i.setCredentials(NewCredentials(c.config))
 		input = i
 	}
 
-	b, err := input.payload()
+	body, size, err := newBody(input)
 	if err != nil {
 		return
 	}
 
 	url := strings.TrimRight(c.config.RealmHost(), "/") + input.uri()
-	req, err = http.NewRequest(input.method(), url, bytes.NewBuffer(b))
+	req, err = http.NewRequestWithContext(ctx, input.method(), url, body)
 	if err != nil {
+		if c, ok := body.(io.Closer); ok {
+			c.Close()
+		}
 		return
 	}
 
+	// Streamed payloads are sent with chunked encoding if their size is
+	// unknown.
+	if size >= 0 {
+		req.ContentLength = size
+	}
+
 	input.headers(req)
 	return
 }
 
+// newBody returns the body of the request and its size, or -1 if the size is
+// unknown. The body is streamed if the input implements StreamingInput.
+func newBody(input Input) (io.Reader, int64, error) {
+	if s, ok := input.(StreamingInput); ok {
+		return s.body()
+	}
+
+	b, err := input.payload()
+	if err != nil {
+		return nil, 0, err
+	}
+	return bytes.NewBuffer(b), int64(len(b)), nil
+}
+
 // Do makes a request to the Quick Base API. This method sets some context
 // about the request, initializes the request via the NewRequest method,
 // invokes each plugins PreRequest method, uses *Client.HTTPClient to make
 // the actual request, invokes each plugin's PostResponse method, then
-// unmarshals the raw response into the passed Output struct.
+// unmarshals the raw response into the passed Output struct. The raw response
+// is also stored in the Output struct, see ResponseParams.RawResponse.
 func (c Client) Do(input Input, output Output) error {
-	ctx := context.Background()
+	return c.DoWithContext(context.Background(), input, output)
+}
+
+// DoWithContext is the same as Do with the addition of the ability to pass a
+// context. The context is passed to each plugin's PreRequest method, and the
+// context returned by the plugins is attached to the *http.Request so that
+// the request is aborted if the context is canceled or its deadline passes.
+// Requests that fail with a transient error are retried according to
+// Client.Retry, and each plugin is invoked once per attempt.
+func (c Client) DoWithContext(ctx context.Context, input Input, output Output) error {
 	ctx = context.WithValue(ctx, CtxKeyRealmHost, c.config.RealmHost())
+	ctx = context.WithValue(ctx, CtxKeyUserToken, c.config.UserToken())
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			resetOutput(output)
+		}
+
+		actx := context.WithValue(ctx, CtxKeyAttempt, attempt)
+		action, res, err := c.attempt(actx, input, output)
+		if !c.Retry.shouldRetry(ctx, action, attempt, res, output, err) {
+			return err
+		}
+
+		if err := sleep(ctx, c.Retry.delay(attempt, res)); err != nil {
+			return err
+		}
+	}
+}
 
-	req, err := c.NewRequest(input)
+// attempt makes a single request to the Quick Base API, returning the action
+// and the response. The response is nil if the request failed.
+func (c Client) attempt(ctx context.Context, input Input, output Output) (action string, res *http.Response, err error) {
+	req, err := c.NewRequestWithContext(ctx, input)
 	if err != nil {
-		return err
+		return
 	}
 
-	ctx = context.WithValue(ctx, CtxKeyAction, req.Header.Get("QUICKBASE-ACTION"))
+	action = req.Header.Get("QUICKBASE-ACTION")
+	ctx = context.WithValue(ctx, CtxKeyAction, action)
 	ctx = c.invokePreRequest(ctx, req)
+	req = req.WithContext(ctx)
 
-	res, err := c.HTTPClient.Do(req)
+	res, err = c.HTTPClient.Do(req)
 	if err != nil {
 		ctx = c.invokePostResponse(ctx, req, res, []byte(""), err)
-		return err
+		return
 	}
 
 	defer res.Body.Close()
 	body, err := ioutil.ReadAll(res.Body)
 	ctx = c.invokePostResponse(ctx, req, res, body, err)
 	if err != nil {
-		return err
+		return
 	}
 
+	output.setRawResponse(body)
 	err = output.parse(body, res)
-	if err != nil {
-		return err
+	if err != nil && res.StatusCode >= 400 {
+		err = fmt.Errorf("unexpected response from Quick Base: %s", res.Status)
 	}
 
-	return nil
+	return
+}
+
+// resetOutput sets output to its zero value so that a response from a
+// previous attempt doesn't leak into the next one.
+func resetOutput(output Output) {
+	v := reflect.ValueOf(output)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v.Elem().Set(reflect.Zero(v.Elem().Type()))
+	}
 }
 
 // invokePreRequest invokes each plugin's PreRequest method.
@@ -140,12 +219,31 @@ func parseJSON(output Output, body []byte, res *http.Response) error {
 	return json.Unmarshal(body, output)
 }
 
+// isXMLResponse returns whether the body starts with an XML declaration or
+// the root element of an API response.
+func isXMLResponse(body []byte) bool {
+	body = bytes.TrimSpace(body)
+	return bytes.HasPrefix(body, []byte("<?xml")) || bytes.HasPrefix(body, []byte("<qdbapi>"))
+}
+
 // parseHTML parses an HTML response, populating output with data.
 func parseHTML(output HTMLOutput, body []byte, res *http.Response) error {
 
-	c, err := strconv.Atoi(res.Header.Get("QUICKBASE-ERRCODE"))
-	if err != nil {
-		return err
+	// Errors might be returned as an XML response instead of in the headers.
+	// Only XML documents with a "qdbapi" root are treated as such, since a
+	// page might contain "<qdbapi>" anywhere, e.g. a code page that parses API
+	// responses, or be an XML document itself, e.g. an XSL stylesheet.
+	h := res.Header.Get("QUICKBASE-ERRCODE")
+	if h == "" && isXMLResponse(body) && xml.Unmarshal(body, output) == nil {
+		return nil
+	}
+
+	c := 0
+	if h != "" {
+		var err error
+		if c, err = strconv.Atoi(h); err != nil {
+			return err
+		}
 	}
 
 	output.setErrorCode(c)
@@ -1,8 +1,13 @@
 package qb
 
 import (
+	"bytes"
+	"context"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"testing"
+	"time"
 )
 
 func NewServerClientPair(fn http.HandlerFunc) (*httptest.Server, Client) {
@@ -17,3 +22,87 @@ func NewServerClientPair(fn http.HandlerFunc) (*httptest.Server, Client) {
 
 	return server, client
 }
+
+func TestDoRawResponse(t *testing.T) {
+	body, err := ioutil.ReadFile("testdata/API_DoQuery_Response.xml")
+	if err != nil {
+		t.Fatalf("error reading test data: %s", err)
+	}
+
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write(body)
+	})
+	defer server.Close()
+
+	out, err := client.DoQuery(&DoQueryInput{TableID: "bpdhfphi2"})
+	if err != nil {
+		t.Fatalf("error executing query: %s", err)
+	}
+
+	if !bytes.Equal(out.RawResponse(), body) {
+		t.Error("expected the raw response to match the response body")
+	}
+	if len(out.Records) == 0 {
+		t.Error("expected the response to also be parsed")
+	}
+}
+
+type testPlugin struct {
+	pre, post context.Context
+}
+
+func (p *testPlugin) PreRequest(ctx context.Context, req *http.Request) context.Context {
+	p.pre = ctx
+	return context.WithValue(ctx, testCtxKey, "plugin")
+}
+
+func (p *testPlugin) PostResponse(ctx context.Context, req *http.Request, res *http.Response, body []byte, err error) context.Context {
+	p.post = ctx
+	return ctx
+}
+
+type testCtxKeyType int
+
+const testCtxKey testCtxKeyType = 0
+
+func TestDoWithContextPlugins(t *testing.T) {
+	server, client := NewServerClientPair(authenticateSuccessHandler)
+	defer server.Close()
+
+	plugin := &testPlugin{}
+	client.Plugins = []Plugin{plugin}
+
+	ctx := context.WithValue(context.Background(), testCtxKey, "caller")
+	if _, err := client.AuthenticateWithContext(ctx, &AuthenticateInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if plugin.pre.Value(testCtxKey) != "caller" {
+		t.Error("expected the caller's context to be passed to PreRequest")
+	}
+	if plugin.pre.Value(CtxKeyAction) != "API_Authenticate" {
+		t.Errorf("expected action 'API_Authenticate', got '%v'", plugin.pre.Value(CtxKeyAction))
+	}
+	if plugin.post.Value(testCtxKey) != "plugin" {
+		t.Error("expected the context returned by PreRequest to be passed to PostResponse")
+	}
+}
+
+func TestDoWithContextCanceled(t *testing.T) {
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	})
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := client.DoQueryWithContext(ctx, &DoQueryInput{TableID: "bpdhfphi2"})
+	if err == nil {
+		t.Fatal("expected an error when the deadline is exceeded")
+	}
+	if ctx.Err() != context.DeadlineExceeded {
+		t.Errorf("expected the deadline to be exceeded, got %v", ctx.Err())
+	}
+}
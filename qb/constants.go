@@ -9,6 +9,8 @@ type ctxKey int
 const (
 	CtxKeyAction ctxKey = iota
 	CtxKeyRealmHost
+	CtxKeyAttempt
+	CtxKeyUserToken
 )
 
 // Default* constants contain configuration defaults.
@@ -17,6 +19,16 @@ const (
 	DefaultTicketFile = "$HOME/.config/quickbase/ticket"
 )
 
+// DefaultPageSize is the number of records requested per API_DoQuery call
+// when iterating over pages of records.
+const DefaultPageSize = 1000
+
+// PageType* constants contain valid Quick Base page types.
+const (
+	PageTypeCode      = 1
+	PageTypeExactForm = 3
+)
+
 // FieldMode* constants contain valid Quick Base field mode settings.
 const (
 	FieldModeVirtual = "virtual"
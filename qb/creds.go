@@ -1,12 +1,15 @@
 package qb
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 // TicketHours is the default expiry for a ticket.
@@ -41,14 +44,20 @@ func NewCredentials(cfg Config) Credentials {
 // NewTicket calls the API_Authenticate endpoint to create a ticket that can
 // be used to authenticate subsequent API requests. If a ticket is returned,
 // it is cached in the ticket file.
-func (c Client) NewTicket(cachefile, username, password string, hours int) (output AuthenticateOutput, err error) {
+func (c Client) NewTicket(cachefile, username, password string, hours int) (AuthenticateOutput, error) {
+	return c.NewTicketWithContext(context.Background(), cachefile, username, password, hours)
+}
+
+// NewTicketWithContext is the same as NewTicket with the addition of the
+// ability to pass a context.
+func (c Client) NewTicketWithContext(ctx context.Context, cachefile, username, password string, hours int) (output AuthenticateOutput, err error) {
 	input := &AuthenticateInput{
 		Username: username,
 		Password: password,
 		Hours:    hours,
 	}
 
-	output, err = c.Authenticate(input)
+	output, err = c.AuthenticateWithContext(ctx, input)
 	if err != nil {
 		return
 	}
@@ -78,34 +87,112 @@ func CacheTicket(file string, output AuthenticateOutput) error {
 		return err
 	}
 
-	// Write the ticket to the ticket file.
-	b := []byte(fmt.Sprintf("%s\n", output.Ticket))
-	return ioutil.WriteFile(file, b, 0600)
+	// Write the ticket to the first line of the ticket file, followed by
+	// metadata about the ticket in key=value format.
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s\n", output.Ticket)
+	if output.UserID != "" {
+		fmt.Fprintf(&buf, "userid=%s\n", output.UserID)
+	}
+	if output.Username != "" {
+		fmt.Fprintf(&buf, "username=%s\n", output.Username)
+	}
+	if !output.Expires.IsZero() {
+		fmt.Fprintf(&buf, "expires=%s\n", output.Expires.Format(time.RFC3339))
+	}
+
+	return ioutil.WriteFile(file, buf.Bytes(), 0600)
 }
 
 // ReadCachedTicket reads the data in the ticket file and returns the ticket
 // if it exists.
 func ReadCachedTicket(file string) (string, error) {
+	info, err := ReadCachedTicketInfo(file)
+	return info.Ticket, err
+}
+
+// TicketInfo contains a cached ticket and the metadata stored alongside it.
+type TicketInfo struct {
+	Ticket   string
+	UserID   string
+	Username string
+	Expires  time.Time
+}
+
+// Expired returns true if the ticket's expiry is known and has passed.
+func (i TicketInfo) Expired() bool {
+	return !i.Expires.IsZero() && time.Now().After(i.Expires)
+}
+
+// ReadCachedTicketInfo reads the data in the ticket file and returns the
+// ticket and its metadata if it exists. Ticket files that only contain a
+// ticket are supported, in which case the metadata is empty.
+func ReadCachedTicketInfo(file string) (info TicketInfo, err error) {
 
 	// Ensure the file exists.
 	stat, err := os.Stat(file)
 	if os.IsNotExist(err) {
-		return "", nil
+		return info, nil
 	} else if err != nil {
-		return "", TicketFileError{file, err}
+		return info, TicketFileError{file, err}
 	} else if stat.IsDir() {
-		return "", errors.New("cache file is a directory, expected a regular file")
+		return info, errors.New("cache file is a directory, expected a regular file")
 	}
 
 	// Read the contents of the file.
 	b, err := ioutil.ReadFile(file)
 	if err != nil {
-		return "", err
+		return
 	}
 
-	// Return the cached ticket.
+	// The ticket is on the first line, metadata is on subsequent lines.
 	// TODO validate the ticket.
-	return strings.TrimRight(string(b), "\n"), nil
+	lines := strings.Split(strings.TrimRight(string(b), "\n"), "\n")
+	info.Ticket = strings.TrimSpace(lines[0])
+	for _, line := range lines[1:] {
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) < 2 {
+			continue
+		}
+		switch kv[0] {
+		case "userid":
+			info.UserID = kv[1]
+		case "username":
+			info.Username = kv[1]
+		case "expires":
+			info.Expires, _ = time.Parse(time.RFC3339, kv[1])
+		}
+	}
+
+	return info, nil
+}
+
+// DeleteTicket calls the API_SignOut endpoint and removes the ticket file.
+// The ticket file is removed even if the API call fails so that the ticket
+// is no longer used by subsequent API requests.
+func (c Client) DeleteTicket(cachefile string) (SignOutOutput, error) {
+	return c.DeleteTicketWithContext(context.Background(), cachefile)
+}
+
+// DeleteTicketWithContext is the same as DeleteTicket with the addition of
+// the ability to pass a context.
+func (c Client) DeleteTicketWithContext(ctx context.Context, cachefile string) (output SignOutOutput, err error) {
+	output, err = c.SignOutWithContext(ctx, &SignOutInput{})
+
+	if rerr := RemoveCachedTicket(cachefile); rerr != nil && err == nil {
+		err = TicketFileError{cachefile, rerr}
+	}
+
+	return
+}
+
+// RemoveCachedTicket removes the ticket file if it exists.
+func RemoveCachedTicket(file string) error {
+	err := os.Remove(file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
 }
 
 // TicketFileError implents the error interface and records an error reading
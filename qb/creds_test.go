@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"testing"
+	"time"
 )
 
 const mockAppToken = "cvukcpupfz3a3ed6qyctybymhwy9"
@@ -173,3 +174,91 @@ func TestNewTicketBadCreds(t *testing.T) {
 		t.Fatalf("expected error code '20', got '%v'", out.ErrorCode)
 	}
 }
+
+func TestReadCachedTicketInfo(t *testing.T) {
+
+	server, client := NewServerClientPair(authenticateSuccessHandler)
+	defer server.Close()
+
+	file := TempFile(t)
+	defer os.Remove(file)
+
+	before := time.Now()
+	if _, err := client.NewTicket(file, "username", "password", 4); err != nil {
+		t.Fatalf("error requesting new ticket: %s", err)
+	}
+
+	info, err := ReadCachedTicketInfo(file)
+	if err != nil {
+		t.Fatalf("error reading ticket file: %s", err)
+	}
+
+	if info.Ticket != mockTicket {
+		t.Errorf("expected ticket '%s', got '%s'", mockTicket, info.Ticket)
+	}
+	if info.UserID != mockUserID {
+		t.Errorf("expected user id '%s', got '%s'", mockUserID, info.UserID)
+	}
+	if info.Username != "username" {
+		t.Errorf("expected username 'username', got '%s'", info.Username)
+	}
+	if info.Expires.Before(before.Add(4 * time.Hour).Truncate(time.Second)) {
+		t.Errorf("expected ticket to expire in 4 hours, got '%s'", info.Expires)
+	}
+	if info.Expired() {
+		t.Error("expected ticket to not be expired")
+	}
+}
+
+func TestReadCachedTicketWithoutMetadata(t *testing.T) {
+	file := TempFile(t)
+	defer os.Remove(file)
+
+	if err := ioutil.WriteFile(file, []byte(mockTicket+"\n"), 0600); err != nil {
+		t.Fatalf("error writing ticket file: %s", err)
+	}
+
+	info, err := ReadCachedTicketInfo(file)
+	if err != nil {
+		t.Fatalf("error reading ticket file: %s", err)
+	}
+
+	if info.Ticket != mockTicket {
+		t.Errorf("expected ticket '%s', got '%s'", mockTicket, info.Ticket)
+	}
+	if info.Expired() {
+		t.Error("expected ticket with unknown expiry to not be expired")
+	}
+}
+
+func signOutSuccessHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`<?xml version="1.0" ?>
+		<qdbapi>
+			<action>API_SignOut</action>
+			<errcode>0</errcode>
+			<errtext>No error</errtext>
+		</qdbapi>`))
+}
+
+func TestDeleteTicket(t *testing.T) {
+
+	server, client := NewServerClientPair(signOutSuccessHandler)
+	defer server.Close()
+
+	file := TempFile(t)
+	defer os.Remove(file)
+
+	if _, err := client.DeleteTicket(file); err != nil {
+		t.Fatalf("error deleting ticket: %s", err)
+	}
+
+	if _, err := os.Stat(file); !os.IsNotExist(err) {
+		t.Error("expected ticket file to be removed")
+	}
+
+	// Deleting a ticket that isn't cached is not an error.
+	if _, err := client.DeleteTicket(file); err != nil {
+		t.Errorf("unexpected error deleting missing ticket: %s", err)
+	}
+}
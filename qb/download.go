@@ -0,0 +1,159 @@
+package qb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// DownloadFileAction is the action passed to plugins via CtxKeyAction when a
+// file is downloaded. Files aren't downloaded through the API, so there is no
+// corresponding Quick Base action.
+const DownloadFileAction = "DownloadFile"
+
+// DownloadFileInput models the request that downloads a file attachment.
+// See https://help.quickbase.com/api-guide/downloading_files.html
+type DownloadFileInput struct {
+	Credentials
+
+	TableID  string
+	RecordID int
+	FieldID  int
+
+	// Version is the version of the file being downloaded. Zero downloads
+	// the latest version.
+	Version int
+}
+
+func (input *DownloadFileInput) setCredentials(creds Credentials) { input.Credentials = creds }
+func (input *DownloadFileInput) method() string                   { return http.MethodGet }
+func (input *DownloadFileInput) payload() ([]byte, error)         { return nil, nil }
+func (input *DownloadFileInput) headers(req *http.Request)        {}
+
+// uri returns the path of the file. The credentials are passed in the query
+// string as documented for the /up/ endpoint, because the request is a GET
+// request without a payload. See RedactURL.
+func (input *DownloadFileInput) uri() string {
+	uri := fmt.Sprintf("/up/%s/a/r%d/e%d/v%d", input.TableID, input.RecordID, input.FieldID, input.Version)
+
+	q := url.Values{}
+	if input.UserToken != "" {
+		q.Set("usertoken", input.UserToken)
+	}
+	if input.Ticket != "" {
+		q.Set("ticket", input.Ticket)
+	}
+	if input.AppToken != "" {
+		q.Set("apptoken", input.AppToken)
+	}
+	if len(q) > 0 {
+		uri += "?" + q.Encode()
+	}
+
+	return uri
+}
+
+// credentialParams are the query string parameters that RedactURL redacts.
+var credentialParams = []string{"usertoken", "ticket", "apptoken"}
+
+// RedactURL returns u as a string with the values of the credentials passed
+// in the query string replaced with "REDACTED". Plugins that log the URL of
+// the request should use it, because files are downloaded with the
+// credentials in the query string.
+func RedactURL(u *url.URL) string {
+	q := u.Query()
+	redacted := false
+	for _, param := range credentialParams {
+		if q.Get(param) != "" {
+			q.Set(param, "REDACTED")
+			redacted = true
+		}
+	}
+	if !redacted {
+		return u.String()
+	}
+
+	r := *u
+	r.RawQuery = q.Encode()
+	return r.String()
+}
+
+// redactError redacts the credentials in the URL of a *url.Error, which is
+// returned by http.Client.Do and includes the URL in its message.
+func redactError(err error) error {
+	if uerr, ok := err.(*url.Error); ok {
+		if u, perr := url.Parse(uerr.URL); perr == nil {
+			uerr.URL = RedactURL(u)
+		}
+	}
+	return err
+}
+
+// DownloadFileOutput models the response returned when a file attachment is
+// downloaded.
+type DownloadFileOutput struct {
+	FileName    string `json:"file_name,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Size        int64  `json:"size"`
+}
+
+// DownloadFile downloads a file attachment and writes it to w.
+// See https://help.quickbase.com/api-guide/downloading_files.html
+func (c Client) DownloadFile(input *DownloadFileInput, w io.Writer) (DownloadFileOutput, error) {
+	return c.DownloadFileWithContext(context.Background(), input, w)
+}
+
+// DownloadFileWithContext is the same as DownloadFile with the addition of
+// the ability to pass a context. Plugins are invoked with DownloadFileAction
+// as the action and a nil body, since the file is streamed to w. Downloads
+// aren't retried, because part of the file might already be written to w.
+// The credentials are redacted from errors returned by the HTTP client.
+func (c Client) DownloadFileWithContext(ctx context.Context, input *DownloadFileInput, w io.Writer) (output DownloadFileOutput, err error) {
+	ctx = context.WithValue(ctx, CtxKeyRealmHost, c.config.RealmHost())
+	ctx = context.WithValue(ctx, CtxKeyUserToken, c.config.UserToken())
+	ctx = context.WithValue(ctx, CtxKeyAction, DownloadFileAction)
+
+	req, err := c.NewRequestWithContext(ctx, input)
+	if err != nil {
+		return
+	}
+
+	ctx = c.invokePreRequest(ctx, req)
+	req = req.WithContext(ctx)
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		err = redactError(err)
+		c.invokePostResponse(ctx, req, res, nil, err)
+		return
+	}
+	defer res.Body.Close()
+
+	// Quick Base reports errors such as invalid credentials in headers.
+	if code, _ := strconv.Atoi(res.Header.Get("QUICKBASE-ERRCODE")); code != 0 {
+		err = &APIError{
+			Action: DownloadFileAction,
+			Code:   code,
+			Text:   res.Header.Get("QUICKBASE-ERRTEXT"),
+		}
+	} else if res.StatusCode != http.StatusOK {
+		err = fmt.Errorf("unexpected response from Quick Base: %s", res.Status)
+	}
+	if err != nil {
+		c.invokePostResponse(ctx, req, res, nil, err)
+		return
+	}
+
+	output.ContentType = res.Header.Get("Content-Type")
+	if _, params, perr := mime.ParseMediaType(res.Header.Get("Content-Disposition")); perr == nil {
+		output.FileName = params["filename"]
+	}
+
+	output.Size, err = io.Copy(w, res.Body)
+	c.invokePostResponse(ctx, req, res, nil, err)
+	return
+}
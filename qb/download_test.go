@@ -0,0 +1,111 @@
+package qb
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestDownloadFile(t *testing.T) {
+	var uri string
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		uri = r.URL.RequestURI()
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", `attachment; filename="report.pdf"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("%PDF-1.4"))
+	})
+	defer server.Close()
+	client.config.(StandardConfig).Set("user-token", "b2ab3c_token")
+
+	var buf bytes.Buffer
+	out, err := client.DownloadFile(&DownloadFileInput{TableID: "bpdhfphi2", RecordID: 12, FieldID: 9}, &buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "/up/bpdhfphi2/a/r12/e9/v0?usertoken=b2ab3c_token"; uri != expected {
+		t.Errorf("expected request to %s, got %s", expected, uri)
+	}
+	if buf.String() != "%PDF-1.4" {
+		t.Errorf("unexpected file contents: %q", buf.String())
+	}
+	if out.FileName != "report.pdf" || out.ContentType != "application/pdf" || out.Size != 8 {
+		t.Errorf("unexpected output: %+v", out)
+	}
+}
+
+func TestDownloadFileError(t *testing.T) {
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("QUICKBASE-ERRCODE", "4")
+		w.Header().Set("QUICKBASE-ERRTEXT", "User not authorized")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("<html></html>"))
+	})
+	defer server.Close()
+
+	var buf bytes.Buffer
+	_, err := client.DownloadFile(&DownloadFileInput{TableID: "bpdhfphi2", RecordID: 12, FieldID: 9}, &buf)
+	if !IsAuthError(err) {
+		t.Errorf("expected auth error, got %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing to be written, got %q", buf.String())
+	}
+}
+
+func TestDownloadFileNotFound(t *testing.T) {
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer server.Close()
+
+	var buf bytes.Buffer
+	_, err := client.DownloadFile(&DownloadFileInput{TableID: "bpdhfphi2", RecordID: 12, FieldID: 9, Version: 2}, &buf)
+	if err == nil || err.Error() != "unexpected response from Quick Base: 404 Not Found" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDownloadFileRequestErrorHidesCredentials(t *testing.T) {
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {})
+	server.Close()
+	client.config.(StandardConfig).Set("user-token", "b2ab3c_token")
+	client.config.(StandardConfig).Set("ticket", "9_ticket")
+
+	var buf bytes.Buffer
+	_, err := client.DownloadFile(&DownloadFileInput{TableID: "bpdhfphi2", RecordID: 12, FieldID: 9}, &buf)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	msg := err.Error()
+	if strings.Contains(msg, "b2ab3c_token") || strings.Contains(msg, "9_ticket") {
+		t.Errorf("credentials leaked in error: %s", msg)
+	}
+	if !strings.Contains(msg, "/up/bpdhfphi2/a/r12/e9/v0?") || !strings.Contains(msg, "usertoken=REDACTED") {
+		t.Errorf("expected the redacted URL in error: %s", msg)
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{"https://example.quickbase.com/up/bpdhfphi2/a/r1/e9/v0", "https://example.quickbase.com/up/bpdhfphi2/a/r1/e9/v0"},
+		{"https://example.quickbase.com/up/bpdhfphi2/a/r1/e9/v0?usertoken=secret", "https://example.quickbase.com/up/bpdhfphi2/a/r1/e9/v0?usertoken=REDACTED"},
+		{"https://example.quickbase.com/up/bpdhfphi2/a/r1/e9/v0?apptoken=app&ticket=tkt", "https://example.quickbase.com/up/bpdhfphi2/a/r1/e9/v0?apptoken=REDACTED&ticket=REDACTED"},
+	}
+
+	for _, tt := range tests {
+		u, err := url.Parse(tt.url)
+		if err != nil {
+			t.Fatalf("error parsing %s: %s", tt.url, err)
+		}
+		if actual := RedactURL(u); actual != tt.expected {
+			t.Errorf("expected %s, got %s", tt.expected, actual)
+		}
+	}
+}
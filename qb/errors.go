@@ -0,0 +1,183 @@
+package qb
+
+import "fmt"
+
+// ErrCode* constants contain the error codes documented by Quick Base.
+const (
+	ErrCodeNoError                   = 0
+	ErrCodeUnknown                   = 1
+	ErrCodeInvalidInput              = 2
+	ErrCodeInsufficientPermissions   = 3
+	ErrCodeBadTicket                 = 4
+	ErrCodeUnimplementedOperation    = 5
+	ErrCodeSyntaxError               = 6
+	ErrCodeAPINotAllowedOnAppTable   = 7
+	ErrCodeSSLRequired               = 8
+	ErrCodeInvalidChoice             = 9
+	ErrCodeInvalidFieldType          = 10
+	ErrCodeCouldNotParseXML          = 11
+	ErrCodeInvalidSourceDBID         = 12
+	ErrCodeInvalidAccountID          = 13
+	ErrCodeMissingDBID               = 14
+	ErrCodeInvalidHostname           = 15
+	ErrCodeUnauthorizedIP            = 19
+	ErrCodeUnknownUsernamePassword   = 20
+	ErrCodeUnknownUser               = 21
+	ErrCodeSignInRequired            = 22
+	ErrCodeFeatureNotSupported       = 23
+	ErrCodeInvalidAppToken           = 24
+	ErrCodeDuplicateAppToken         = 25
+	ErrCodeMaxCount                  = 26
+	ErrCodeRegistrationRequired      = 27
+	ErrCodeManagedByLDAP             = 28
+	ErrCodeNoSuchRecord              = 30
+	ErrCodeNoSuchField               = 31
+	ErrCodeNoSuchDatabase            = 32
+	ErrCodeNoSuchQuery               = 33
+	ErrCodeCannotChangeField         = 34
+	ErrCodeNoDataReturned            = 35
+	ErrCodeCloningError              = 36
+	ErrCodeNoSuchReport              = 37
+	ErrCodeRestrictedFieldInReport   = 38
+	ErrCodeMissingRequiredField      = 50
+	ErrCodeNonUniqueValue            = 51
+	ErrCodeDuplicateField            = 52
+	ErrCodeUpdateConflict            = 60
+	ErrCodeSchemaLocked              = 61
+	ErrCodeAccountSizeLimitExceeded  = 70
+	ErrCodeDatabaseSizeLimitExceeded = 71
+	ErrCodeAccountSuspended          = 73
+	ErrCodeCreateAppNotAllowed       = 74
+	ErrCodeViewTooLarge              = 75
+	ErrCodeTooManyCriteria           = 76
+	ErrCodeRequestLimitExceeded      = 77
+	ErrCodeDataLimitExceeded         = 78
+	ErrCodeOverflow                  = 80
+	ErrCodeItemNotFound              = 81
+	ErrCodeOperationTookTooLong      = 82
+	ErrCodeAccessDenied              = 83
+	ErrCodeDatabaseError             = 84
+	ErrCodeSchemaUpdateError         = 85
+	ErrCodeTechnicalDifficulties     = 100
+	ErrCodeInvalidRole               = 110
+	ErrCodeUserExists                = 111
+	ErrCodeNoUserInRole              = 112
+	ErrCodeUserAlreadyInRole         = 113
+	ErrCodeMustBeAdminUser           = 114
+	ErrCodeUpgradePlan               = 150
+	ErrCodeExpiredPlan               = 151
+	ErrCodeAppSuspended              = 152
+)
+
+// ErrorCategory* constants contain the categories that APIErrors are grouped
+// into, see APIError.Category.
+const (
+	ErrorCategoryAPI         = "api"
+	ErrorCategoryAuth        = "auth"
+	ErrorCategoryNotFound    = "not_found"
+	ErrorCategoryPermission  = "permission"
+	ErrorCategoryRateLimited = "rate_limited"
+)
+
+// errorCategories maps error codes to categories. Codes that aren't in the
+// map are in the ErrorCategoryAPI category.
+var errorCategories = map[int]string{
+	ErrCodeBadTicket:               ErrorCategoryAuth,
+	ErrCodeUnknownUsernamePassword: ErrorCategoryAuth,
+	ErrCodeUnknownUser:             ErrorCategoryAuth,
+	ErrCodeSignInRequired:          ErrorCategoryAuth,
+	ErrCodeInvalidAppToken:         ErrorCategoryAuth,
+	ErrCodeRegistrationRequired:    ErrorCategoryAuth,
+
+	ErrCodeNoSuchRecord:   ErrorCategoryNotFound,
+	ErrCodeNoSuchField:    ErrorCategoryNotFound,
+	ErrCodeNoSuchDatabase: ErrorCategoryNotFound,
+	ErrCodeNoSuchQuery:    ErrorCategoryNotFound,
+	ErrCodeNoSuchReport:   ErrorCategoryNotFound,
+	ErrCodeItemNotFound:   ErrorCategoryNotFound,
+
+	ErrCodeInsufficientPermissions: ErrorCategoryPermission,
+	ErrCodeUnauthorizedIP:          ErrorCategoryPermission,
+	ErrCodeAccessDenied:            ErrorCategoryPermission,
+	ErrCodeMustBeAdminUser:         ErrorCategoryPermission,
+
+	ErrCodeRequestLimitExceeded: ErrorCategoryRateLimited,
+}
+
+// APIError is the error returned when Quick Base responds with a non-zero
+// error code.
+type APIError struct {
+	Action string
+	Code   int
+	Text   string
+	Detail string
+}
+
+// newAPIError returns an *APIError populated with the error returned in the
+// response to the action.
+func newAPIError(action string, r ResponseParams) *APIError {
+	return &APIError{
+		Action: action,
+		Code:   r.ErrorCode,
+		Text:   r.ErrorText,
+		Detail: r.ErrorDetail,
+	}
+}
+
+// Error implements error.Error.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("error executing %s: %s (error code: %v)", e.Action, e.Text, e.Code)
+}
+
+// ErrorCode returns the Quick Base error code.
+func (e *APIError) ErrorCode() int { return e.Code }
+
+// ErrorDetail returns the detailed error message returned by Quick Base.
+func (e *APIError) ErrorDetail() string { return e.Detail }
+
+// Category returns the category the error belongs to, one of the
+// ErrorCategory* constants.
+func (e *APIError) Category() string {
+	if c, ok := errorCategories[e.Code]; ok {
+		return c
+	}
+	return ErrorCategoryAPI
+}
+
+// AsAPIError returns the *APIError in err's chain of wrapped errors.
+func AsAPIError(err error) (*APIError, bool) {
+	for err != nil {
+		if e, ok := err.(*APIError); ok {
+			return e, true
+		}
+
+		u, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			break
+		}
+		err = u.Unwrap()
+	}
+	return nil, false
+}
+
+// IsAuthError returns whether err is an *APIError caused by invalid or
+// missing credentials, e.g. an expired ticket.
+func IsAuthError(err error) bool { return isCategory(err, ErrorCategoryAuth) }
+
+// IsNotFound returns whether err is an *APIError caused by a record, field,
+// table, query, or report that doesn't exist.
+func IsNotFound(err error) bool { return isCategory(err, ErrorCategoryNotFound) }
+
+// IsPermissionError returns whether err is an *APIError caused by the user
+// not being allowed to perform the action.
+func IsPermissionError(err error) bool { return isCategory(err, ErrorCategoryPermission) }
+
+// IsRateLimited returns whether err is an *APIError caused by exceeding the
+// API request limit.
+func IsRateLimited(err error) bool { return isCategory(err, ErrorCategoryRateLimited) }
+
+// isCategory returns whether err is an *APIError in the category.
+func isCategory(err error, category string) bool {
+	e, ok := AsAPIError(err)
+	return ok && e.Category() == category
+}
@@ -0,0 +1,70 @@
+package qb
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<qdbapi><action>API_DoQuery</action><errcode>4</errcode><errtext>Bad ticket</errtext><errdetail>Your ticket has expired.</errdetail></qdbapi>`))
+	})
+	defer server.Close()
+
+	_, err := client.DoQuery(&DoQueryInput{})
+	e, ok := AsAPIError(err)
+	if !ok {
+		t.Fatalf("expected an *APIError, got %T", err)
+	}
+
+	expected := APIError{Action: "API_DoQuery", Code: ErrCodeBadTicket, Text: "Bad ticket", Detail: "Your ticket has expired."}
+	if *e != expected {
+		t.Errorf("expected %+v, got %+v", expected, *e)
+	}
+	if err.Error() != "error executing API_DoQuery: Bad ticket (error code: 4)" {
+		t.Errorf("unexpected error message: %s", err)
+	}
+}
+
+type wrappedError struct{ err error }
+
+func (e wrappedError) Error() string { return "wrapped: " + e.err.Error() }
+func (e wrappedError) Unwrap() error { return e.err }
+
+func TestErrorCategories(t *testing.T) {
+	tests := []struct {
+		err         error
+		category    string
+		auth        bool
+		notFound    bool
+		permission  bool
+		rateLimited bool
+	}{
+		{&APIError{Code: ErrCodeBadTicket}, ErrorCategoryAuth, true, false, false, false},
+		{&APIError{Code: ErrCodeNoSuchField}, ErrorCategoryNotFound, false, true, false, false},
+		{&APIError{Code: ErrCodeAccessDenied}, ErrorCategoryPermission, false, false, true, false},
+		{&APIError{Code: ErrCodeRequestLimitExceeded}, ErrorCategoryRateLimited, false, false, false, true},
+		{&APIError{Code: ErrCodeInvalidInput}, ErrorCategoryAPI, false, false, false, false},
+		{wrappedError{&APIError{Code: ErrCodeNoSuchRecord}}, ErrorCategoryNotFound, false, true, false, false},
+		{errors.New("some error"), "", false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		if e, ok := AsAPIError(tt.err); ok && e.Category() != tt.category {
+			t.Errorf("%v: expected category %q, got %q", tt.err, tt.category, e.Category())
+		}
+		if IsAuthError(tt.err) != tt.auth {
+			t.Errorf("%v: expected IsAuthError to return %v", tt.err, tt.auth)
+		}
+		if IsNotFound(tt.err) != tt.notFound {
+			t.Errorf("%v: expected IsNotFound to return %v", tt.err, tt.notFound)
+		}
+		if IsPermissionError(tt.err) != tt.permission {
+			t.Errorf("%v: expected IsPermissionError to return %v", tt.err, tt.permission)
+		}
+		if IsRateLimited(tt.err) != tt.rateLimited {
+			t.Errorf("%v: expected IsRateLimited to return %v", tt.err, tt.rateLimited)
+		}
+	}
+}
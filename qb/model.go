@@ -3,6 +3,7 @@ package qb
 import (
 	"encoding/xml"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 )
@@ -40,6 +41,18 @@ type AuthenticatedInput interface {
 	setCredentials(Credentials)
 }
 
+// StreamingInput is the interface implemented by structs that model requests
+// whose payload is streamed instead of being buffered in memory, e.g. requests
+// that upload files.
+type StreamingInput interface {
+	Input
+
+	// body returns a reader that streams the payload and the size of the
+	// payload, or -1 if the size is unknown. It is invoked once per attempt,
+	// so a request can be retried.
+	body() (io.Reader, int64, error)
+}
+
 // Output is the interface implemented by structs that model responses
 // returned from Quick Base API requests.
 type Output interface {
@@ -58,6 +71,12 @@ type Output interface {
 
 	// setErrorText sets the detailed error message returned by Quick Base.
 	setErrorDetail(string)
+
+	// setRawResponse stores the unparsed response body.
+	setRawResponse([]byte)
+
+	// errorCode returns the numeric error code returned by Quick Base.
+	errorCode() int
 }
 
 // HTMLOutput is the interfaces implemented by structs that model responses
@@ -78,12 +97,21 @@ type ResponseParams struct {
 	ErrorText   string   `xml:"errtext" json:"-"`
 	ErrorDetail string   `xml:"errdetail" json:"-"`
 	UserData    string   `xml:"udata,omitempty" json:"user_data,omitempty"`
+
+	// raw is the unparsed response body returned by Quick Base.
+	raw []byte
 }
 
 func (r *ResponseParams) setAction(a string)      { r.Action = a }
 func (r *ResponseParams) setErrorCode(c int)      { r.ErrorCode = c }
 func (r *ResponseParams) setErrorText(t string)   { r.ErrorText = t }
 func (r *ResponseParams) setErrorDetail(d string) { r.ErrorDetail = d }
+func (r *ResponseParams) setRawResponse(b []byte) { r.raw = b }
+func (r *ResponseParams) errorCode() int          { return r.ErrorCode }
+
+// RawResponse returns the unparsed response body exactly as it was returned
+// by Quick Base, which is useful for debugging.
+func (r ResponseParams) RawResponse() []byte { return r.raw }
 
 // FieldList models field lists in API requests.
 type FieldList []int
@@ -107,3 +135,18 @@ func (b Bool) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	}
 	return e.EncodeElement(s, start)
 }
+
+// Role models the "role" element in responses that contain an application's
+// roles, e.g. API_GetRoleInfo.
+type Role struct {
+	RoleID int        `xml:"id,attr" json:"role_id"`
+	Name   string     `xml:"name" json:"name"`
+	Access RoleAccess `xml:"access" json:"access"`
+}
+
+// RoleAccess models the "role>access" element, which is the role's level of
+// access to the application.
+type RoleAccess struct {
+	AccessID int    `xml:"id,attr" json:"access_id"`
+	Name     string `xml:",chardata" json:"name"`
+}
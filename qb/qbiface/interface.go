@@ -1,20 +1,107 @@
 package qbiface
 
 import (
+	"context"
+	"io"
+
 	"github.com/cpliakas/quickbase-do-query/qb"
 )
 
 // ClientAPI provides an interface to enable mocking the Quick Base service
 // client's API calls.
 type ClientAPI interface {
+	AddReplaceDBPage(*qb.AddReplaceDBPageInput) (qb.AddReplaceDBPageOutput, error)
+	AddReplaceDBPageWithContext(context.Context, *qb.AddReplaceDBPageInput) (qb.AddReplaceDBPageOutput, error)
+	AddUserToRole(*qb.AddUserToRoleInput) (qb.AddUserToRoleOutput, error)
+	AddUserToRoleWithContext(context.Context, *qb.AddUserToRoleInput) (qb.AddUserToRoleOutput, error)
+	ChangeRecordOwner(*qb.ChangeRecordOwnerInput) (qb.ChangeRecordOwnerOutput, error)
+	ChangeRecordOwnerWithContext(context.Context, *qb.ChangeRecordOwnerInput) (qb.ChangeRecordOwnerOutput, error)
+	ChangeUserRole(*qb.ChangeUserRoleInput) (qb.ChangeUserRoleOutput, error)
+	ChangeUserRoleWithContext(context.Context, *qb.ChangeUserRoleInput) (qb.ChangeUserRoleOutput, error)
+	CloneDatabase(*qb.CloneDatabaseInput) (qb.CloneDatabaseOutput, error)
+	CloneDatabaseWithContext(context.Context, *qb.CloneDatabaseInput) (qb.CloneDatabaseOutput, error)
 	Config() qb.Config
 
+	AddField(*qb.AddFieldInput) (qb.AddFieldOutput, error)
+	AddFieldWithContext(context.Context, *qb.AddFieldInput) (qb.AddFieldOutput, error)
 	AddRecord(*qb.AddRecordInput) (qb.AddRecordOutput, error)
+	AddRecordWithContext(context.Context, *qb.AddRecordInput) (qb.AddRecordOutput, error)
 	Authenticate(*qb.AuthenticateInput) (qb.AuthenticateOutput, error)
+	AuthenticateWithContext(context.Context, *qb.AuthenticateInput) (qb.AuthenticateOutput, error)
+	CopyMasterDetail(*qb.CopyMasterDetailInput) (qb.CopyMasterDetailOutput, error)
+	CopyMasterDetailWithContext(context.Context, *qb.CopyMasterDetailInput) (qb.CopyMasterDetailOutput, error)
+	CreateDatabase(*qb.CreateDatabaseInput) (qb.CreateDatabaseOutput, error)
+	CreateDatabaseWithContext(context.Context, *qb.CreateDatabaseInput) (qb.CreateDatabaseOutput, error)
+	CreateTable(*qb.CreateTableInput) (qb.CreateTableOutput, error)
+	CreateTableWithContext(context.Context, *qb.CreateTableInput) (qb.CreateTableOutput, error)
+	DeleteDatabase(*qb.DeleteDatabaseInput) (qb.DeleteDatabaseOutput, error)
+	DeleteDatabaseWithContext(context.Context, *qb.DeleteDatabaseInput) (qb.DeleteDatabaseOutput, error)
+	DeleteField(*qb.DeleteFieldInput) (qb.DeleteFieldOutput, error)
+	DeleteFieldWithContext(context.Context, *qb.DeleteFieldInput) (qb.DeleteFieldOutput, error)
+	DeleteRecord(*qb.DeleteRecordInput) (qb.DeleteRecordOutput, error)
+	DeleteRecordWithContext(context.Context, *qb.DeleteRecordInput) (qb.DeleteRecordOutput, error)
 	DoQuery(*qb.DoQueryInput) (qb.DoQueryOutput, error)
+	DoQueryWithContext(context.Context, *qb.DoQueryInput) (qb.DoQueryOutput, error)
+	DoQueryCount(*qb.DoQueryCountInput) (qb.DoQueryCountOutput, error)
+	DoQueryCountWithContext(context.Context, *qb.DoQueryCountInput) (qb.DoQueryCountOutput, error)
+	DoQueryPages(*qb.DoQueryInput, func(qb.DoQueryOutput, bool) bool) error
+	DoQueryPagesWithContext(context.Context, *qb.DoQueryInput, func(qb.DoQueryOutput, bool) bool) error
+	DownloadFile(*qb.DownloadFileInput, io.Writer) (qb.DownloadFileOutput, error)
+	DownloadFileWithContext(context.Context, *qb.DownloadFileInput, io.Writer) (qb.DownloadFileOutput, error)
 	EditRecord(*qb.EditRecordInput) (qb.EditRecordOutput, error)
+	EditRecordWithContext(context.Context, *qb.EditRecordInput) (qb.EditRecordOutput, error)
+	FieldAddChoices(*qb.FieldAddChoicesInput) (qb.FieldAddChoicesOutput, error)
+	FieldAddChoicesWithContext(context.Context, *qb.FieldAddChoicesInput) (qb.FieldAddChoicesOutput, error)
+	FieldRemoveChoices(*qb.FieldRemoveChoicesInput) (qb.FieldRemoveChoicesOutput, error)
+	FieldRemoveChoicesWithContext(context.Context, *qb.FieldRemoveChoicesInput) (qb.FieldRemoveChoicesOutput, error)
+	FindDBByName(*qb.FindDBByNameInput) (qb.FindDBByNameOutput, error)
+	FindDBByNameWithContext(context.Context, *qb.FindDBByNameInput) (qb.FindDBByNameOutput, error)
+	GetAncestorInfo(*qb.GetAncestorInfoInput) (qb.GetAncestorInfoOutput, error)
+	GetAncestorInfoWithContext(context.Context, *qb.GetAncestorInfoInput) (qb.GetAncestorInfoOutput, error)
+	GetDBInfo(*qb.GetDBInfoInput) (qb.GetDBInfoOutput, error)
+	GetDBInfoWithContext(context.Context, *qb.GetDBInfoInput) (qb.GetDBInfoOutput, error)
+	GetDBPage(*qb.GetDBPageInput) (qb.GetDBPageOutput, error)
+	GetDBPageWithContext(context.Context, *qb.GetDBPageInput) (qb.GetDBPageOutput, error)
+	GetNumRecords(*qb.GetNumRecordsInput) (qb.GetNumRecordsOutput, error)
+	GetNumRecordsWithContext(context.Context, *qb.GetNumRecordsInput) (qb.GetNumRecordsOutput, error)
+	GetRecordInfo(*qb.GetRecordInfoInput) (qb.GetRecordInfoOutput, error)
+	GetRecordInfoWithContext(context.Context, *qb.GetRecordInfoInput) (qb.GetRecordInfoOutput, error)
+	GetRoleInfo(*qb.GetRoleInfoInput) (qb.GetRoleInfoOutput, error)
+	GetRoleInfoWithContext(context.Context, *qb.GetRoleInfoInput) (qb.GetRoleInfoOutput, error)
 	GetSchema(*qb.GetSchemaInput) (qb.GetSchemaOutput, error)
+	GetSchemaWithContext(context.Context, *qb.GetSchemaInput) (qb.GetSchemaOutput, error)
+	GetUserInfo(*qb.GetUserInfoInput) (qb.GetUserInfoOutput, error)
+	GetUserInfoWithContext(context.Context, *qb.GetUserInfoInput) (qb.GetUserInfoOutput, error)
+	GetUserRole(*qb.GetUserRoleInput) (qb.GetUserRoleOutput, error)
+	GetUserRoleWithContext(context.Context, *qb.GetUserRoleInput) (qb.GetUserRoleOutput, error)
+	GetVariable(*qb.GetVariableInput) (qb.GetVariableOutput, error)
+	GetVariableWithContext(context.Context, *qb.GetVariableInput) (qb.GetVariableOutput, error)
+	GrantedDBs(*qb.GrantedDBsInput) (qb.GrantedDBsOutput, error)
+	GrantedDBsWithContext(context.Context, *qb.GrantedDBsInput) (qb.GrantedDBsOutput, error)
 	ImportFromCSV(*qb.ImportFromCSVInput) (qb.ImportFromCSVOutput, error)
+	ImportFromCSVWithContext(context.Context, *qb.ImportFromCSVInput) (qb.ImportFromCSVOutput, error)
+	ListDBPages(*qb.ListDBPagesInput) (qb.ListDBPagesOutput, error)
+	ListDBPagesWithContext(context.Context, *qb.ListDBPagesInput) (qb.ListDBPagesOutput, error)
+	ProvisionUser(*qb.ProvisionUserInput) (qb.ProvisionUserOutput, error)
+	ProvisionUserWithContext(context.Context, *qb.ProvisionUserInput) (qb.ProvisionUserOutput, error)
+	PurgeRecords(*qb.PurgeRecordsInput) (qb.PurgeRecordsOutput, error)
+	PurgeRecordsWithContext(context.Context, *qb.PurgeRecordsInput) (qb.PurgeRecordsOutput, error)
+	RemoveUserFromRole(*qb.RemoveUserFromRoleInput) (qb.RemoveUserFromRoleOutput, error)
+	RemoveUserFromRoleWithContext(context.Context, *qb.RemoveUserFromRoleInput) (qb.RemoveUserFromRoleOutput, error)
+	RenameApp(*qb.RenameAppInput) (qb.RenameAppOutput, error)
+	RenameAppWithContext(context.Context, *qb.RenameAppInput) (qb.RenameAppOutput, error)
+	RunImport(*qb.RunImportInput) (qb.RunImportOutput, error)
+	RunImportWithContext(context.Context, *qb.RunImportInput) (qb.RunImportOutput, error)
+	SendInvitation(*qb.SendInvitationInput) (qb.SendInvitationOutput, error)
+	SendInvitationWithContext(context.Context, *qb.SendInvitationInput) (qb.SendInvitationOutput, error)
+	SetFieldProperties(*qb.SetFieldPropertiesInput) (qb.SetFieldPropertiesOutput, error)
+	SetFieldPropertiesWithContext(context.Context, *qb.SetFieldPropertiesInput) (qb.SetFieldPropertiesOutput, error)
 	SetVariable(*qb.SetVariableInput) (qb.SetVariableOutput, error)
+	SetVariableWithContext(context.Context, *qb.SetVariableInput) (qb.SetVariableOutput, error)
+	SignOut(*qb.SignOutInput) (qb.SignOutOutput, error)
+	SignOutWithContext(context.Context, *qb.SignOutInput) (qb.SignOutOutput, error)
 	UploadFile(*qb.UploadFileInput) (qb.UploadFileOutput, error)
+	UploadFileWithContext(context.Context, *qb.UploadFileInput) (qb.UploadFileOutput, error)
+	UserRoles(*qb.UserRolesInput) (qb.UserRolesOutput, error)
+	UserRolesWithContext(context.Context, *qb.UserRolesInput) (qb.UserRolesOutput, error)
 }
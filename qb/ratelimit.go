@@ -0,0 +1,224 @@
+package qb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// RateLimit configures the rate at which requests are sent to a realm. A
+// RateLimit with an empty RealmHost applies to realms that don't have a
+// RateLimit of their own. Quick Base limits requests per user token, so the
+// rate applies to each user token separately. Requests authenticated with a
+// ticket share the rate of the realm.
+//
+// Rate limits are read from the "rate-limit" array of tables in the config
+// file, for example:
+//
+//	[[rate-limit]]
+//	realm-host = "https://MYREALM.quickbase.com"
+//	rate = 5
+//	burst = 10
+type RateLimit struct {
+
+	// RealmHost is the realm host the rate limit applies to.
+	RealmHost string `mapstructure:"realm-host"`
+
+	// Rate is the number of requests per second that are allowed.
+	Rate float64 `mapstructure:"rate"`
+
+	// Burst is the number of requests that can be sent at once before
+	// subsequent requests are delayed. Defaults to 1.
+	Burst int `mapstructure:"burst"`
+}
+
+// RateLimitsFromConfig reads the rate limits in the "rate-limit" key.
+func RateLimitsFromConfig(v *viper.Viper) (limits []RateLimit, err error) {
+	if err = v.UnmarshalKey("rate-limit", &limits); err != nil {
+		return
+	}
+
+	for _, l := range limits {
+		if l.Rate <= 0 {
+			return nil, fmt.Errorf("rate limit for %q invalid: rate must be greater than 0", l.RealmHost)
+		}
+		if l.Burst < 0 {
+			return nil, fmt.Errorf("rate limit for %q invalid: burst must not be negative", l.RealmHost)
+		}
+	}
+
+	return
+}
+
+// RateLimitStats contains metrics about the requests sent to a realm, across
+// all user tokens.
+type RateLimitStats struct {
+
+	// Requests is the number of requests sent.
+	Requests int64
+
+	// Delayed is the number of requests that had to wait to be sent.
+	Delayed int64
+
+	// WaitTime is the total time spent waiting.
+	WaitTime time.Duration
+}
+
+// RateLimiter is a Plugin that limits the rate of requests sent to each
+// realm using a token bucket. A RateLimiter is safe to share between
+// multiple Clients and goroutines, in which case the limits apply to all
+// requests sent through it.
+type RateLimiter struct {
+	mu      sync.Mutex
+	limits  map[string]RateLimit
+	buckets map[bucketKey]*bucket
+	stats   map[string]*RateLimitStats
+}
+
+// bucketKey identifies the token bucket for a realm and user token.
+type bucketKey struct {
+	realm     string
+	userToken string
+}
+
+// NewRateLimiter returns a RateLimiter that enforces the rate limits.
+func NewRateLimiter(limits ...RateLimit) *RateLimiter {
+	l := &RateLimiter{
+		limits:  make(map[string]RateLimit, len(limits)),
+		buckets: make(map[bucketKey]*bucket),
+		stats:   make(map[string]*RateLimitStats),
+	}
+	for _, rl := range limits {
+		l.limits[realmKey(rl.RealmHost)] = rl
+	}
+	return l
+}
+
+// PreRequest implements Plugin.PreRequest by waiting until the request is
+// allowed to be sent. The request fails with the context's error if the
+// context is canceled while waiting.
+func (l *RateLimiter) PreRequest(ctx context.Context, req *http.Request) context.Context {
+	host, _ := ctx.Value(CtxKeyRealmHost).(string)
+	if host == "" {
+		host = req.URL.Host
+	}
+	key := realmKey(host)
+	token, _ := ctx.Value(CtxKeyUserToken).(string)
+
+	l.mu.Lock()
+	b := l.bucket(bucketKey{realm: key, userToken: token})
+	stats := l.stats[key]
+	if stats == nil {
+		stats = &RateLimitStats{}
+		l.stats[key] = stats
+	}
+	stats.Requests++
+
+	var wait time.Duration
+	if b != nil {
+		wait = b.reserve(time.Now())
+	}
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return ctx
+	}
+
+	start := time.Now()
+	err := sleep(ctx, wait)
+
+	l.mu.Lock()
+	stats.Delayed++
+	stats.WaitTime += time.Since(start)
+	if err != nil {
+
+		// Give the token back since the request won't be sent.
+		b.tokens++
+	}
+	l.mu.Unlock()
+
+	return ctx
+}
+
+// PostResponse implements Plugin.PostResponse.
+func (l *RateLimiter) PostResponse(ctx context.Context, req *http.Request, res *http.Response, body []byte, err error) context.Context {
+	return ctx
+}
+
+// Stats returns the metrics for requests sent to the realm.
+func (l *RateLimiter) Stats(realmHost string) RateLimitStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if s, ok := l.stats[realmKey(realmHost)]; ok {
+		return *s
+	}
+	return RateLimitStats{}
+}
+
+// bucket returns the token bucket for the realm and user token, or nil if
+// requests to the realm aren't limited. The caller must hold the lock.
+func (l *RateLimiter) bucket(key bucketKey) *bucket {
+	if b, ok := l.buckets[key]; ok {
+		return b
+	}
+
+	rl, ok := l.limits[key.realm]
+	if !ok {
+		rl, ok = l.limits[""]
+	}
+
+	var b *bucket
+	if ok && rl.Rate > 0 {
+		burst := float64(rl.Burst)
+		if burst < 1 {
+			burst = 1
+		}
+		b = &bucket{rate: rl.Rate, burst: burst, tokens: burst, last: time.Now()}
+	}
+
+	l.buckets[key] = b
+	return b
+}
+
+// bucket is a token bucket. Tokens are added at a constant rate up to the
+// burst size, and each request takes one.
+type bucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// reserve takes a token and returns how long the caller must wait before it
+// is available. Tokens may be taken before they are available, so concurrent
+// callers are queued in the order that they reserve tokens.
+func (b *bucket) reserve(now time.Time) time.Duration {
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// realmKey normalizes the realm host so that, for example,
+// "https://MYREALM.quickbase.com/" and "myrealm.quickbase.com" are the same.
+func realmKey(host string) string {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if u, err := url.Parse(host); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return strings.TrimSuffix(host, "/")
+}
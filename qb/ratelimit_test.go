@@ -0,0 +1,195 @@
+package qb
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func TestBucketReserve(t *testing.T) {
+	now := time.Now()
+	b := &bucket{rate: 2, burst: 2, tokens: 2, last: now}
+
+	tests := []struct {
+		elapsed time.Duration
+		wait    time.Duration
+	}{
+		{0, 0},
+		{0, 0},
+		{0, 500 * time.Millisecond},
+		{0, time.Second},
+		{2 * time.Second, 0},
+	}
+
+	for i, tt := range tests {
+		now = now.Add(tt.elapsed)
+		if wait := b.reserve(now); wait != tt.wait {
+			t.Errorf("reservation %v: expected wait of %s, got %s", i, tt.wait, wait)
+		}
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<qdbapi><action>API_DoQuery</action><errcode>0</errcode></qdbapi>`))
+	})
+	defer server.Close()
+
+	limiter := NewRateLimiter(RateLimit{RealmHost: server.URL, Rate: 20, Burst: 2})
+	client.Plugins = []Plugin{limiter}
+
+	// The first two requests use the burst, and the remaining four are sent
+	// at 20 requests per second.
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.DoQuery(&DoQueryInput{}); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Errorf("expected requests to take at least 200ms, took %s", elapsed)
+	}
+
+	stats := limiter.Stats(server.URL)
+	if stats.Requests != 6 {
+		t.Errorf("expected 6 requests, got %v", stats.Requests)
+	}
+	if stats.Delayed != 4 {
+		t.Errorf("expected 4 delayed requests, got %v", stats.Delayed)
+	}
+	if stats.WaitTime <= 0 {
+		t.Error("expected time spent waiting to be recorded")
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	limiter := NewRateLimiter(RateLimit{RealmHost: "https://other.quickbase.com", Rate: 1})
+	if b := limiter.bucket(bucketKey{realm: realmKey("https://example.quickbase.com")}); b != nil {
+		t.Error("expected realms without a rate limit not to be limited")
+	}
+
+	limiter = NewRateLimiter(RateLimit{Rate: 1})
+	if b := limiter.bucket(bucketKey{realm: realmKey("https://example.quickbase.com")}); b == nil {
+		t.Error("expected the default rate limit to apply")
+	}
+}
+
+func TestRateLimiterPerUserToken(t *testing.T) {
+	limiter := NewRateLimiter(RateLimit{Rate: 1})
+	req, _ := http.NewRequest("POST", "https://example.quickbase.com/db/main", nil)
+
+	newCtx := func(token string) context.Context {
+		ctx := context.WithValue(context.Background(), CtxKeyRealmHost, "https://example.quickbase.com")
+		return context.WithValue(ctx, CtxKeyUserToken, token)
+	}
+
+	// Each user token has its own bucket, so neither request is delayed.
+	limiter.PreRequest(newCtx("token1"), req)
+	limiter.PreRequest(newCtx("token2"), req)
+	if stats := limiter.Stats("example.quickbase.com"); stats.Delayed != 0 {
+		t.Errorf("expected requests with different user tokens not to be delayed, %v were", stats.Delayed)
+	}
+
+	// A second request with the same user token is delayed. The context is
+	// canceled so that the test doesn't wait.
+	ctx, cancel := context.WithCancel(newCtx("token1"))
+	cancel()
+	limiter.PreRequest(ctx, req)
+
+	stats := limiter.Stats("example.quickbase.com")
+	if stats.Requests != 3 {
+		t.Errorf("expected 3 requests, got %v", stats.Requests)
+	}
+	if stats.Delayed != 1 {
+		t.Errorf("expected requests with the same user token to be delayed, %v were", stats.Delayed)
+	}
+}
+
+func TestRateLimiterSharedByDownloads(t *testing.T) {
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("QUICKBASE-ACTION") == "" {
+			w.Write([]byte("file contents"))
+			return
+		}
+		w.Write([]byte(`<qdbapi><action>API_DoQuery</action><errcode>0</errcode></qdbapi>`))
+	})
+	defer server.Close()
+	client.config.(StandardConfig).Set("user-token", "b2ab3c_token")
+
+	limiter := NewRateLimiter(RateLimit{RealmHost: server.URL, Rate: 20})
+	client.Plugins = []Plugin{limiter}
+
+	if _, err := client.DoQuery(&DoQueryInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var buf bytes.Buffer
+	if _, err := client.DownloadFile(&DownloadFileInput{TableID: "bpdhfphi2", RecordID: 1, FieldID: 9}, &buf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The download uses the same user token as the API call, so it waits for
+	// the bucket to refill.
+	if stats := limiter.Stats(server.URL); stats.Delayed != 1 {
+		t.Errorf("expected the download to share the API call's bucket, %v requests were delayed", stats.Delayed)
+	}
+}
+
+func TestRateLimitsFromConfig(t *testing.T) {
+	config := []byte(`
+[[rate-limit]]
+realm-host = "https://example.quickbase.com"
+rate = 5
+burst = 10
+
+[[rate-limit]]
+rate = 2.5
+`)
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	if err := v.ReadConfig(bytes.NewBuffer(config)); err != nil {
+		t.Fatalf("error reading config: %s", err)
+	}
+
+	limits, err := RateLimitsFromConfig(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []RateLimit{
+		{RealmHost: "https://example.quickbase.com", Rate: 5, Burst: 10},
+		{Rate: 2.5},
+	}
+	if len(limits) != len(expected) {
+		t.Fatalf("expected %v rate limits, got %v", len(expected), len(limits))
+	}
+	for i := range expected {
+		if limits[i] != expected[i] {
+			t.Errorf("expected %+v, got %+v", expected[i], limits[i])
+		}
+	}
+}
+
+func TestRealmKey(t *testing.T) {
+	for _, host := range []string{
+		"https://example.quickbase.com",
+		"https://EXAMPLE.quickbase.com/",
+		"example.quickbase.com",
+	} {
+		if key := realmKey(host); key != "example.quickbase.com" {
+			t.Errorf("%s: expected example.quickbase.com, got %s", host, key)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package qb
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default* constants contain retry policy defaults.
+const (
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+	DefaultRetryMaxDelay  = 30 * time.Second
+)
+
+// DefaultRetryErrorCodes are the Quick Base error codes that indicate a
+// transient failure, i.e. the request was throttled or the service was
+// temporarily unable to process it.
+var DefaultRetryErrorCodes = []int{
+	ErrCodeRequestLimitExceeded,
+	ErrCodeOperationTookTooLong,
+	ErrCodeTechnicalDifficulties,
+}
+
+// idempotentActions contains the actions that are safe to retry, because
+// sending the same request more than once has no side effects.
+var idempotentActions = map[string]bool{
+	"API_Authenticate":    true,
+	"API_DoQuery":         true,
+	"API_DoQueryCount":    true,
+	"API_FindDBByName":    true,
+	"API_GetAncestorInfo": true,
+	"API_GetDBInfo":       true,
+	"API_GetDBPage":       true,
+	"API_GetDBvar":        true,
+	"API_GetNumRecords":   true,
+	"API_GetRecordInfo":   true,
+	"API_GetRoleInfo":     true,
+	"API_GetSchema":       true,
+	"API_GetUserInfo":     true,
+	"API_GetUserRole":     true,
+	"API_GrantedDBs":      true,
+	"API_ListDBPages":     true,
+	"API_SignOut":         true,
+	"API_UserRoles":       true,
+}
+
+// RetryPolicy configures how requests that fail with a transient error are
+// retried. Network errors, HTTP 429 and 5xx responses, and responses with
+// one of the ErrorCodes are considered transient. The zero value disables
+// retries.
+type RetryPolicy struct {
+
+	// MaxAttempts is the maximum number of times a request is attempted,
+	// including the first attempt. Values less than 2 disable retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry, which is doubled for
+	// each subsequent retry. Defaults to DefaultRetryBaseDelay.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between retries, including delays requested
+	// via the Retry-After header. Defaults to DefaultRetryMaxDelay.
+	MaxDelay time.Duration
+
+	// ErrorCodes are the Quick Base error codes that are retried. Defaults to
+	// DefaultRetryErrorCodes if nil.
+	ErrorCodes []int
+
+	// RetryWrites enables retries for actions that modify data. Retrying a
+	// write that Quick Base processed but failed to respond to, e.g. adding a
+	// record, might apply the change more than once.
+	RetryWrites bool
+}
+
+// NewRetryPolicy returns a RetryPolicy populated with default values that
+// makes at most maxAttempts attempts.
+func NewRetryPolicy(maxAttempts int) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: maxAttempts,
+		BaseDelay:   DefaultRetryBaseDelay,
+		MaxDelay:    DefaultRetryMaxDelay,
+		ErrorCodes:  DefaultRetryErrorCodes,
+	}
+}
+
+// shouldRetry returns whether a failed attempt at the action is retried. The
+// response is nil if the request failed at the network level.
+func (p RetryPolicy) shouldRetry(ctx context.Context, action string, attempt int, res *http.Response, output Output, err error) bool {
+	if attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+	if !idempotentActions[action] && !p.RetryWrites {
+		return false
+	}
+
+	if res == nil {
+		return err != nil
+	}
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500 {
+		return true
+	}
+	if err != nil {
+		return false
+	}
+
+	codes := p.ErrorCodes
+	if codes == nil {
+		codes = DefaultRetryErrorCodes
+	}
+	for _, c := range codes {
+		if output.errorCode() == c {
+			return true
+		}
+	}
+	return false
+}
+
+// delay returns how long to wait before the next attempt. The Retry-After
+// header is honored if the response has one, capped at MaxDelay, otherwise
+// the delay grows exponentially with a random jitter so that concurrent
+// clients don't retry in lockstep.
+func (p RetryPolicy) delay(attempt int, res *http.Response) time.Duration {
+	base, max := p.BaseDelay, p.MaxDelay
+	if base <= 0 {
+		base = DefaultRetryBaseDelay
+	}
+	if max <= 0 {
+		max = DefaultRetryMaxDelay
+	}
+
+	if res != nil {
+		if d, ok := retryAfter(res.Header.Get("Retry-After")); ok {
+			if d > max {
+				d = max
+			}
+			return d
+		}
+	}
+
+	d := base << uint(attempt-1)
+	if d > max || d <= 0 {
+		d = max
+	}
+
+	// Wait somewhere between half and all of the computed delay.
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// retryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// sleep waits for the duration, returning early with the context's error if
+// it is canceled.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,157 @@
+package qb
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// retryHandler fails the first n requests with the passed status code and
+// Quick Base error code, then succeeds.
+func retryHandler(n, status, errcode int, attempts *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*attempts++
+		if *attempts <= n {
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				w.Write([]byte("<html>unavailable</html>"))
+				return
+			}
+			w.Write([]byte(`<qdbapi><action>API_DoQuery</action><errcode>` + strconv.Itoa(errcode) + `</errcode><errtext>error</errtext></qdbapi>`))
+			return
+		}
+		w.Write([]byte(`<qdbapi><action>API_DoQuery</action><errcode>0</errcode><errtext>No error</errtext></qdbapi>`))
+	}
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		errcode  int
+		failures int
+		writes   bool
+		input    Input
+		attempts int
+		err      bool
+	}{
+		{"server error", http.StatusServiceUnavailable, 0, 2, false, &DoQueryInput{}, 3, false},
+		{"too many requests", http.StatusTooManyRequests, 0, 1, false, &DoQueryInput{}, 2, false},
+		{"retryable error code", http.StatusOK, 77, 1, false, &DoQueryInput{}, 2, false},
+		{"other error code", http.StatusOK, 2, 1, false, &DoQueryInput{}, 1, true},
+		{"attempts exhausted", http.StatusBadGateway, 0, 5, false, &DoQueryInput{}, 3, true},
+		{"write not retried", http.StatusServiceUnavailable, 0, 1, false, &AddRecordInput{}, 1, true},
+		{"write retried", http.StatusServiceUnavailable, 0, 1, true, &AddRecordInput{}, 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			server, client := NewServerClientPair(retryHandler(tt.failures, tt.status, tt.errcode, &attempts))
+			defer server.Close()
+
+			client.Retry = NewRetryPolicy(3)
+			client.Retry.BaseDelay = time.Millisecond
+			client.Retry.RetryWrites = tt.writes
+
+			output := &DoQueryOutput{}
+			err := client.Do(tt.input, output)
+			failed := err != nil || output.ErrorCode != 0
+
+			if attempts != tt.attempts {
+				t.Errorf("expected %v attempts, got %v", tt.attempts, attempts)
+			}
+			if failed != tt.err {
+				t.Errorf("expected failure to be %v, got error %v and error code %v", tt.err, err, output.ErrorCode)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	attempts := 0
+	var retried time.Time
+	start := time.Now()
+
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		retried = time.Now()
+		w.Write([]byte(`<qdbapi><action>API_DoQuery</action><errcode>0</errcode></qdbapi>`))
+	})
+	defer server.Close()
+
+	client.Retry = NewRetryPolicy(2)
+	client.Retry.BaseDelay = time.Millisecond
+
+	if _, err := client.DoQuery(&DoQueryInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if retried.Sub(start) < time.Second {
+		t.Errorf("expected the Retry-After header to be honored, retried after %s", retried.Sub(start))
+	}
+}
+
+func TestRetryContextCanceled(t *testing.T) {
+	attempts := 0
+	server, client := NewServerClientPair(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer server.Close()
+
+	client.Retry = NewRetryPolicy(5)
+	client.Retry.BaseDelay = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.DoQueryWithContext(ctx, &DoQueryInput{})
+	if err != context.DeadlineExceeded {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %v", attempts)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	p := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{1, 50 * time.Millisecond, 100 * time.Millisecond},
+		{2, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 200 * time.Millisecond, 400 * time.Millisecond},
+		{10, 500 * time.Millisecond, time.Second},
+	}
+
+	for _, tt := range tests {
+		d := p.delay(tt.attempt, nil)
+		if d < tt.min || d > tt.max {
+			t.Errorf("attempt %v: expected delay between %s and %s, got %s", tt.attempt, tt.min, tt.max, d)
+		}
+	}
+}
+
+func TestRetryDelayCapsRetryAfter(t *testing.T) {
+	p := RetryPolicy{MaxDelay: 2 * time.Second}
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+
+	if d := p.delay(1, res); d != 2*time.Second {
+		t.Errorf("expected Retry-After to be capped at 2s, got %s", d)
+	}
+
+	res.Header.Set("Retry-After", "1")
+	if d := p.delay(1, res); d != time.Second {
+		t.Errorf("expected Retry-After of 1s to be honored, got %s", d)
+	}
+}
@@ -1,7 +1,10 @@
 package qbutil
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -35,11 +38,17 @@ func NewGlobalConfig(cmd *cobra.Command, cfg *viper.Viper) GlobalConfig {
 	flags.PersistentBool("batch", "B", false, "render output in batch mode, useful for chaining commands together")
 	flags.PersistentString("config-file", "C", qb.DefaultConfigFile, "path to the config file")
 	flags.PersistentString("filter", "F", "", "JMESPath filter")
+	flags.PersistentString("output", "O", "", "output format, one of "+strings.Join(cliutil.RendererNames(), ", ")+" (default json)")
+	flags.PersistentBool("rate-limit-stats", "", false, "write the number of requests and the time spent waiting on rate limits to STDERR")
 	flags.PersistentBool("raw", "X", false, "return the raw output from the API call")
 	flags.PersistentString("realm-host", "R", "", "realm host, e.g., 'https://MYREALM.quickbase.com'")
+	flags.PersistentInt("retries", "", 0, "number of times requests that fail with a transient error are retried")
+	flags.PersistentBool("retry-writes", "", false, "also retry requests that modify data, which might apply a change more than once")
 	flags.PersistentString("table-id", "t", "", "table's dbid")
+	flags.PersistentString("template", "", "", "Go template used to render output, implies --output=template")
 	flags.PersistentString("ticket", "T", "", "ticket used to authenticate API requests")
 	flags.PersistentString("ticket-file", "K", qb.DefaultTicketFile, "path to the file containing a cached ticket")
+	flags.PersistentDuration("timeout", "", 0, "maximum time the command may take, e.g. 30s, 0 for no timeout")
 	flags.PersistentString("user-token", "U", "", "user token used to authenticate API requests")
 
 	return GlobalConfig{viper: cfg}
@@ -65,21 +74,65 @@ func (c GlobalConfig) ConfigFile() string { return c.viper.GetString("config-fil
 // Filter returns the JMESPath filter.
 func (c GlobalConfig) Filter() string { return c.viper.GetString("filter") }
 
+// RenderOptions returns the options that control how output is rendered.
+func (c GlobalConfig) RenderOptions() cliutil.RenderOptions {
+	return cliutil.RenderOptions{
+		Filter:   c.Filter(),
+		Raw:      c.Raw(),
+		Batch:    c.Batch(),
+		Format:   c.Output(),
+		Template: c.Template(),
+	}
+}
+
+// Output returns the output format.
+func (c GlobalConfig) Output() string { return c.viper.GetString("output") }
+
 // Raw flags whether to return the raw output from the API as opposed to JSON.
 func (c GlobalConfig) Raw() bool { return c.viper.GetBool("raw") }
 
+// RateLimits returns the rate limits read from the config file.
+func (c GlobalConfig) RateLimits() ([]qb.RateLimit, error) {
+	return qb.RateLimitsFromConfig(c.viper)
+}
+
+// RateLimitStats returns whether the rate limiting metrics are written to
+// STDERR when the command finishes.
+func (c GlobalConfig) RateLimitStats() bool { return c.viper.GetBool("rate-limit-stats") }
+
 // RealmHost implements qb.Config.RealmHost.
 func (c GlobalConfig) RealmHost() string { return c.viper.GetString("realm-host") }
 
+// Retries returns the number of times requests that fail with a transient
+// error are retried.
+func (c GlobalConfig) Retries() int { return c.viper.GetInt("retries") }
+
+// RetryWrites returns whether requests that modify data are retried.
+func (c GlobalConfig) RetryWrites() bool { return c.viper.GetBool("retry-writes") }
+
+// RetryPolicy returns the policy used to retry failed requests.
+func (c GlobalConfig) RetryPolicy() qb.RetryPolicy {
+	p := qb.NewRetryPolicy(c.Retries() + 1)
+	p.RetryWrites = c.RetryWrites()
+	return p
+}
+
 // TableID returns the configured table's dbid.
 func (c GlobalConfig) TableID() string { return c.viper.GetString("table-id") }
 
+// Template returns the Go template used to render output.
+func (c GlobalConfig) Template() string { return c.viper.GetString("template") }
+
 // Ticket implements qb.Config.Ticket.
 func (c GlobalConfig) Ticket() string { return c.viper.GetString("ticket") }
 
 // TicketFile implements qb.Config.TicketFile.
 func (c GlobalConfig) TicketFile() string { return c.viper.GetString("ticket-file") }
 
+// Timeout returns the maximum time the command may take. Zero means there is
+// no timeout.
+func (c GlobalConfig) Timeout() time.Duration { return c.viper.GetDuration("timeout") }
+
 // UserToken implements qb.Config.UserToken.
 func (c GlobalConfig) UserToken() string { return c.viper.GetString("user-token") }
 
@@ -105,8 +158,34 @@ func (c *GlobalConfig) Validate() error {
 		return fmt.Errorf("realm-host option invalid: %s", err)
 	}
 
+	// Validate the filter option so that a bad expression is caught before
+	// any requests are made.
+	if c.Filter() != "" {
+		if c.Raw() {
+			return errors.New("filter option cannot be used with the raw option")
+		}
+		if _, err := cliutil.CompileFilter(c.Filter()); err != nil {
+			return fmt.Errorf("filter option invalid: %s", err)
+		}
+	}
+
+	if c.Timeout() < 0 {
+		return errors.New("timeout option invalid: must not be negative")
+	}
+	if c.Retries() < 0 {
+		return errors.New("retries option invalid: must not be negative")
+	}
+	if _, err := c.RateLimits(); err != nil {
+		return fmt.Errorf("error reading configuration: %s", err)
+	}
+
+	// Validate the output and template options.
+	if err := c.validateOutput(); err != nil {
+		return err
+	}
+
 	// Validate the app-id option.
-	if c.RequireTableID {
+	if c.RequireAppID || c.RequireTableID {
 		if err := validation.Validate(c.AppID(),
 			validation.Required,
 			validation.Length(9, 9),
@@ -127,3 +206,28 @@ func (c *GlobalConfig) Validate() error {
 
 	return nil
 }
+
+// validateOutput validates the output and template options so that invalid
+// options are caught before any requests are made.
+func (c *GlobalConfig) validateOutput() error {
+	opts := c.RenderOptions()
+
+	if (c.Output() != "" || c.Template() != "") && c.Raw() {
+		return errors.New("output and template options cannot be used with the raw option")
+	}
+
+	if _, err := cliutil.GetRenderer(opts.FormatName()); err != nil {
+		return fmt.Errorf("output option invalid: %s", err)
+	}
+
+	if opts.FormatName() == cliutil.FormatNameTemplate {
+		if opts.Template == "" {
+			return errors.New("missing required option: template")
+		}
+		if _, err := cliutil.CompileTemplate(opts.Template); err != nil {
+			return fmt.Errorf("template option invalid: %s", err)
+		}
+	}
+
+	return nil
+}